@@ -0,0 +1,234 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"runtime"
+	"sync"
+)
+
+const (
+	// ChunkedCodecMagic identifies framed output produced by ChunkedCodec.
+	ChunkedCodecMagic uint32 = 0x474C4B43 // "GLKC"
+
+	// DefaultChunkSize is the size, in bytes, of each chunk before compression.
+	DefaultChunkSize = 256 * 1024
+
+	// DefaultChunkedThreshold is the minimum payload size before ChunkedCodec is worth using.
+	DefaultChunkedThreshold = 64 * 1024
+)
+
+const chunkedHeaderSize = 4 + 4 + 4 + 1 // magic, chunkSize, chunkCount, flags
+
+type chunkIndexEntry struct {
+	compLen uint32
+	rawLen  uint32
+	crc32   uint32
+}
+
+const chunkIndexEntrySize = 4 + 4 + 4
+
+// ChunkedCodec splits large values into fixed-size chunks, compresses each chunk in parallel
+// across a worker pool, and frames the output with a header, a chunk index, and per-chunk
+// CRC32 checksums so a single corrupted chunk can be identified precisely on decode.
+type ChunkedCodec struct {
+	// Inner is the codec used to compress/decompress each individual chunk.
+	Inner Codec
+
+	// ChunkSize is the size, in bytes, each input chunk is split into before compression.
+	ChunkSize int
+
+	// Workers bounds how many chunks are processed concurrently. Defaults to GOMAXPROCS.
+	Workers int
+}
+
+// NewChunkedCodec returns a ChunkedCodec wrapping inner with the package defaults.
+func NewChunkedCodec(inner Codec) *ChunkedCodec {
+	return &ChunkedCodec{
+		Inner:     inner,
+		ChunkSize: DefaultChunkSize,
+		Workers:   runtime.GOMAXPROCS(0),
+	}
+}
+
+func (c *ChunkedCodec) Name() string { return "chunked-" + c.Inner.Name() }
+
+func (c *ChunkedCodec) chunkSize() int {
+	if c.ChunkSize <= 0 {
+		return DefaultChunkSize
+	}
+	return c.ChunkSize
+}
+
+func (c *ChunkedCodec) workers() int {
+	if c.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return c.Workers
+}
+
+// Compress splits src into chunks, compresses them in parallel, and emits the framed output:
+// a header, a chunk index, then the concatenated compressed chunks.
+func (c *ChunkedCodec) Compress(dst, src []byte) ([]byte, error) {
+	chunkSize := c.chunkSize()
+	chunkCount := (len(src) + chunkSize - 1) / chunkSize
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+
+	compressed := make([][]byte, chunkCount)
+	index := make([]chunkIndexEntry, chunkCount)
+	errs := make([]error, chunkCount)
+
+	jobs := make(chan int, chunkCount)
+	var wg sync.WaitGroup
+	for w := 0; w < c.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				start := i * chunkSize
+				end := start + chunkSize
+				if end > len(src) {
+					end = len(src)
+				}
+				raw := src[start:end]
+
+				out, err := c.Inner.Compress(nil, raw)
+				if err != nil {
+					errs[i] = fmt.Errorf("chunked codec: failed to compress chunk %d: %w", i, err)
+					continue
+				}
+				compressed[i] = out
+				index[i] = chunkIndexEntry{
+					compLen: uint32(len(out)),
+					rawLen:  uint32(len(raw)),
+					crc32:   crc32.ChecksumIEEE(raw),
+				}
+			}
+		}()
+	}
+	for i := 0; i < chunkCount; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := dst
+	header := make([]byte, chunkedHeaderSize)
+	binary.LittleEndian.PutUint32(header[0:4], ChunkedCodecMagic)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(chunkSize))
+	binary.LittleEndian.PutUint32(header[8:12], uint32(chunkCount))
+	header[12] = 0 // flags, reserved for future use
+	out = append(out, header...)
+
+	for _, entry := range index {
+		entryBytes := make([]byte, chunkIndexEntrySize)
+		binary.LittleEndian.PutUint32(entryBytes[0:4], entry.compLen)
+		binary.LittleEndian.PutUint32(entryBytes[4:8], entry.rawLen)
+		binary.LittleEndian.PutUint32(entryBytes[8:12], entry.crc32)
+		out = append(out, entryBytes...)
+	}
+
+	for _, chunk := range compressed {
+		out = append(out, chunk...)
+	}
+
+	return out, nil
+}
+
+// Decompress reads the index, dispatches chunk decompression across the worker pool, and
+// verifies the CRC32 of each chunk before assembling the result. A mismatched checksum or a
+// chunk that fails to decompress produces an error naming the offending chunk index.
+func (c *ChunkedCodec) Decompress(dst, src []byte) ([]byte, error) {
+	if len(src) < chunkedHeaderSize {
+		return nil, fmt.Errorf("chunked codec: input too short for header")
+	}
+
+	magic := binary.LittleEndian.Uint32(src[0:4])
+	if magic != ChunkedCodecMagic {
+		return nil, fmt.Errorf("chunked codec: bad magic %#x", magic)
+	}
+	chunkCount := int(binary.LittleEndian.Uint32(src[8:12]))
+
+	offset := chunkedHeaderSize
+	index := make([]chunkIndexEntry, chunkCount)
+	for i := 0; i < chunkCount; i++ {
+		if offset+chunkIndexEntrySize > len(src) {
+			return nil, fmt.Errorf("chunked codec: truncated chunk index at entry %d", i)
+		}
+		entry := src[offset : offset+chunkIndexEntrySize]
+		index[i] = chunkIndexEntry{
+			compLen: binary.LittleEndian.Uint32(entry[0:4]),
+			rawLen:  binary.LittleEndian.Uint32(entry[4:8]),
+			crc32:   binary.LittleEndian.Uint32(entry[8:12]),
+		}
+		offset += chunkIndexEntrySize
+	}
+
+	chunkOffsets := make([]int, chunkCount)
+	for i, entry := range index {
+		chunkOffsets[i] = offset
+		offset += int(entry.compLen)
+	}
+	if offset > len(src) {
+		return nil, fmt.Errorf("chunked codec: truncated chunk data")
+	}
+
+	decoded := make([][]byte, chunkCount)
+	errs := make([]error, chunkCount)
+
+	jobs := make(chan int, chunkCount)
+	var wg sync.WaitGroup
+	for w := 0; w < c.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				entry := index[i]
+				start := chunkOffsets[i]
+				end := start + int(entry.compLen)
+				raw, err := c.Inner.Decompress(nil, src[start:end])
+				if err != nil {
+					errs[i] = fmt.Errorf("chunked codec: failed to decompress chunk %d: %w", i, err)
+					continue
+				}
+				if uint32(len(raw)) != entry.rawLen {
+					errs[i] = fmt.Errorf("chunked codec: chunk %d has unexpected length %d, want %d", i, len(raw), entry.rawLen)
+					continue
+				}
+				if crc32.ChecksumIEEE(raw) != entry.crc32 {
+					errs[i] = fmt.Errorf("chunked codec: chunk %d failed CRC32 integrity check", i)
+					continue
+				}
+				decoded[i] = raw
+			}
+		}()
+	}
+	for i := 0; i < chunkCount; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := dst
+	for _, chunk := range decoded {
+		out = append(out, chunk...)
+	}
+	return out, nil
+}
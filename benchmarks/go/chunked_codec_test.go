@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestChunkedCodec_RoundTrip(t *testing.T) {
+	codec := &ChunkedCodec{Inner: (&zstdCodec{}).WithLevel(3), ChunkSize: 16, Workers: 4}
+
+	src := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 50))
+
+	compressed, err := codec.Compress(nil, src)
+	if err != nil {
+		t.Fatalf("Compress failed: %v", err)
+	}
+
+	decompressed, err := codec.Decompress(nil, compressed)
+	if err != nil {
+		t.Fatalf("Decompress failed: %v", err)
+	}
+
+	if !bytes.Equal(decompressed, src) {
+		t.Fatalf("round trip mismatch: got %d bytes, want %d bytes", len(decompressed), len(src))
+	}
+}
+
+func TestChunkedCodec_CorruptedChunkDetected(t *testing.T) {
+	codec := &ChunkedCodec{Inner: (&zstdCodec{}).WithLevel(3), ChunkSize: 16, Workers: 4}
+
+	src := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 50))
+
+	compressed, err := codec.Compress(nil, src)
+	if err != nil {
+		t.Fatalf("Compress failed: %v", err)
+	}
+
+	// Flip a byte inside the second chunk's compressed payload, well past the header and index.
+	corruptOffset := chunkedHeaderSize + 3*chunkIndexEntrySize + 5
+	if corruptOffset >= len(compressed) {
+		t.Fatalf("test setup: corrupt offset %d out of range (len=%d)", corruptOffset, len(compressed))
+	}
+	compressed[corruptOffset] ^= 0xFF
+
+	_, err = codec.Decompress(nil, compressed)
+	if err == nil {
+		t.Fatal("expected Decompress to fail on corrupted chunk, got nil error")
+	}
+	if !strings.Contains(err.Error(), "chunk") {
+		t.Fatalf("expected error to identify the offending chunk, got: %v", err)
+	}
+}
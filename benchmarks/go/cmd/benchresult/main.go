@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Command benchresult compares two -resultFile outputs from the compression benchmark and
+// prints a table of percentage deltas per metric, keyed on (codec, dataset), so compression
+// changes can be regression-tested across branches the way gRPC's benchmain/benchresult pair
+// compares gRPC benchmarks.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// row mirrors the fields of the compression benchmark's ResultRow that are meaningful to
+// compare across runs. It's kept independent of the benchmark package so this binary has no
+// build dependency on it.
+type row struct {
+	Codec    string  `json:"codec"`
+	Level    int     `json:"level"`
+	Dataset  string  `json:"dataset"`
+	Ratio    float64 `json:"ratio"`
+	TPS      float64 `json:"tps"`
+	SetP50Us int64   `json:"setP50Us"`
+	SetP99Us int64   `json:"setP99Us"`
+	GetP50Us int64   `json:"getP50Us"`
+	GetP99Us int64   `json:"getP99Us"`
+	GitSHA   string  `json:"gitSha"`
+}
+
+func key(r row) string {
+	return fmt.Sprintf("%s/level=%d/%s", r.Codec, r.Level, r.Dataset)
+}
+
+func readRows(path string) (map[string]row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	rows := make(map[string]row)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var r row
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("failed to parse line in %s: %w", path, err)
+		}
+		// Last row for a given key wins, matching how benchmain/benchresult treats reruns.
+		rows[key(r)] = r
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return rows, nil
+}
+
+func pctDelta(before, after float64) float64 {
+	if before == 0 {
+		return 0
+	}
+	return (after - before) / before * 100
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s <before.jsonl> <after.jsonl>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	before, err := readRows(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("benchresult: %v", err)
+	}
+	after, err := readRows(flag.Arg(1))
+	if err != nil {
+		log.Fatalf("benchresult: %v", err)
+	}
+
+	fmt.Printf("%-40s %-10s %10s %10s %10s %10s\n",
+		"Codec/Level/Dataset", "Ratio Δ", "TPS Δ", "SetP50 Δ", "SetP99 Δ", "GetP99 Δ")
+
+	for k, a := range after {
+		b, ok := before[k]
+		if !ok {
+			fmt.Printf("%-40s %10s (no matching row in before file)\n", k, "new")
+			continue
+		}
+		fmt.Printf("%-40s %+9.1f%% %+9.1f%% %+9.1f%% %+9.1f%% %+9.1f%%\n",
+			k,
+			pctDelta(b.Ratio, a.Ratio),
+			pctDelta(b.TPS, a.TPS),
+			pctDelta(float64(b.SetP50Us), float64(a.SetP50Us)),
+			pctDelta(float64(b.SetP99Us), float64(a.SetP99Us)),
+			pctDelta(float64(b.GetP99Us), float64(a.GetP99Us)))
+	}
+
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			fmt.Printf("%-40s %10s (present in before, missing from after)\n", k, "gone")
+		}
+	}
+}
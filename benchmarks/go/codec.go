@@ -0,0 +1,240 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Codec compresses and decompresses values for a single backend, modeled on gRPC's
+// encoding.Codec. Implementations must be safe for concurrent use.
+type Codec interface {
+	// Name returns the registered name of the codec, e.g. "zstd" or "gzip".
+	Name() string
+
+	// Compress appends the compressed form of src to dst and returns the result.
+	Compress(dst, src []byte) ([]byte, error)
+
+	// Decompress appends the decompressed form of src to dst and returns the result.
+	Decompress(dst, src []byte) ([]byte, error)
+}
+
+var (
+	codecRegistryMu sync.RWMutex
+	codecRegistry   = make(map[string]Codec)
+)
+
+// RegisterCodec registers a Codec under its Name(). Registering a codec with a name that
+// is already in use overwrites the previous registration.
+func RegisterCodec(c Codec) {
+	if c == nil || c.Name() == "" {
+		panic("codec: cannot register a nil codec or a codec with an empty name")
+	}
+	codecRegistryMu.Lock()
+	defer codecRegistryMu.Unlock()
+	codecRegistry[c.Name()] = c
+}
+
+// GetCodec returns the codec registered under name, or nil if no such codec exists.
+func GetCodec(name string) Codec {
+	codecRegistryMu.RLock()
+	defer codecRegistryMu.RUnlock()
+	return codecRegistry[name]
+}
+
+// RegisteredCodecNames returns the names of all currently registered codecs.
+func RegisteredCodecNames() []string {
+	codecRegistryMu.RLock()
+	defer codecRegistryMu.RUnlock()
+	names := make([]string, 0, len(codecRegistry))
+	for name := range codecRegistry {
+		names = append(names, name)
+	}
+	return names
+}
+
+func init() {
+	RegisterCodec(&noneCodec{})
+	RegisterCodec((&zstdCodec{}).WithLevel(3))
+	RegisterCodec((&gzipCodec{}).WithLevel(6))
+	RegisterCodec(&s2Codec{})
+	RegisterCodec((&lz4Codec{}).WithLevel(0))
+}
+
+// noneCodec is a passthrough codec used as the baseline for comparison.
+type noneCodec struct{}
+
+func (noneCodec) Name() string { return "none" }
+
+func (noneCodec) Compress(dst, src []byte) ([]byte, error) {
+	return append(dst, src...), nil
+}
+
+func (noneCodec) Decompress(dst, src []byte) ([]byte, error) {
+	return append(dst, src...), nil
+}
+
+// zstdCodec compresses using klauspost/compress/zstd at a configurable level.
+type zstdCodec struct {
+	level zstd.EncoderLevel
+}
+
+func (c *zstdCodec) Name() string { return "zstd" }
+
+// WithLevel returns a copy of the codec configured for the given zstd compression level
+// (1-22, mapped to the nearest klauspost EncoderLevel).
+func (c *zstdCodec) WithLevel(level int) *zstdCodec {
+	return &zstdCodec{level: zstdLevelFromInt(level)}
+}
+
+func zstdLevelFromInt(level int) zstd.EncoderLevel {
+	switch {
+	case level <= 1:
+		return zstd.SpeedFastest
+	case level <= 6:
+		return zstd.SpeedDefault
+	case level <= 12:
+		return zstd.SpeedBetterCompression
+	default:
+		return zstd.SpeedBestCompression
+	}
+}
+
+func (c *zstdCodec) Compress(dst, src []byte) ([]byte, error) {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(c.level))
+	if err != nil {
+		return nil, fmt.Errorf("zstd: failed to create encoder: %w", err)
+	}
+	defer enc.Close()
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (c *zstdCodec) Decompress(dst, src []byte) ([]byte, error) {
+	dec, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, fmt.Errorf("zstd: failed to create decoder: %w", err)
+	}
+	defer dec.Close()
+	out, err := dec.DecodeAll(src, dst)
+	if err != nil {
+		return nil, fmt.Errorf("zstd: decompress failed: %w", err)
+	}
+	return out, nil
+}
+
+// gzipCodec compresses using the standard library's compress/gzip.
+type gzipCodec struct {
+	level int
+}
+
+func (c *gzipCodec) Name() string { return "gzip" }
+
+func (c *gzipCodec) WithLevel(level int) *gzipCodec {
+	return &gzipCodec{level: level}
+}
+
+func (c *gzipCodec) Compress(dst, src []byte) ([]byte, error) {
+	level := c.level
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, level)
+	if err != nil {
+		return nil, fmt.Errorf("gzip: failed to create writer: %w", err)
+	}
+	if _, err := w.Write(src); err != nil {
+		return nil, fmt.Errorf("gzip: write failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("gzip: close failed: %w", err)
+	}
+	return append(dst, buf.Bytes()...), nil
+}
+
+func (c *gzipCodec) Decompress(dst, src []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("gzip: failed to create reader: %w", err)
+	}
+	defer r.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("gzip: read failed: %w", err)
+	}
+	return append(dst, out...), nil
+}
+
+// s2Codec compresses using klauspost/compress/s2, a fast snappy-compatible codec.
+type s2Codec struct{}
+
+func (s2Codec) Name() string { return "s2" }
+
+func (s2Codec) Compress(dst, src []byte) ([]byte, error) {
+	return append(dst, s2.Encode(nil, src)...), nil
+}
+
+func (s2Codec) Decompress(dst, src []byte) ([]byte, error) {
+	out, err := s2.Decode(dst, src)
+	if err != nil {
+		return nil, fmt.Errorf("s2: decompress failed: %w", err)
+	}
+	return out, nil
+}
+
+// lz4Codec compresses using pierrec/lz4.
+type lz4Codec struct {
+	level lz4.CompressionLevel
+}
+
+func (c *lz4Codec) Name() string { return "lz4" }
+
+func (c *lz4Codec) WithLevel(level int) *lz4Codec {
+	return &lz4Codec{level: lz4LevelFromInt(level)}
+}
+
+// lz4LevelFromInt maps a 0-9 compression-level scale onto pierrec/lz4's discrete
+// CompressionLevel constants (Fast, Level1..Level9), which are bit-shifted enum values rather
+// than a contiguous range.
+func lz4LevelFromInt(level int) lz4.CompressionLevel {
+	switch {
+	case level <= 0:
+		return lz4.Fast
+	case level >= 9:
+		return lz4.Level9
+	default:
+		return lz4.CompressionLevel(1 << (8 + uint(level) - 1))
+	}
+}
+
+func (c *lz4Codec) Compress(dst, src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := lz4.NewWriter(&buf)
+	if err := w.Apply(lz4.CompressionLevelOption(c.level)); err != nil {
+		return nil, fmt.Errorf("lz4: failed to apply options: %w", err)
+	}
+	if _, err := w.Write(src); err != nil {
+		return nil, fmt.Errorf("lz4: write failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("lz4: close failed: %w", err)
+	}
+	return append(dst, buf.Bytes()...), nil
+}
+
+func (c *lz4Codec) Decompress(dst, src []byte) ([]byte, error) {
+	r := lz4.NewReader(bytes.NewReader(src))
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("lz4: decompress failed: %w", err)
+	}
+	return append(dst, out...), nil
+}
@@ -5,10 +5,13 @@ package main
 import (
 	"bufio"
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,24 +20,56 @@ import (
 )
 
 const (
-	HOST                  = "localhost"
-	PORT                  = 6379
-	WARMUP_ITERATIONS     = 100
-	BENCHMARK_ITERATIONS  = 1000
-	TPS_TEST_DURATION_MS  = 5000 // 5 seconds
+	HOST                 = "localhost"
+	PORT                 = 6379
+	WARMUP_ITERATIONS    = 100
+	BENCHMARK_ITERATIONS = 1000
+	TPS_TEST_DURATION_MS = 5000 // 5 seconds
 )
 
 // BenchmarkResult holds the results of a benchmark run
 type BenchmarkResult struct {
 	TPS              float64
+	OriginalSize     int64
 	CompressedSize   int64
 	CompressionRatio float64
+
+	SetOps int64
+	GetOps int64
+
+	SetLatency LatencyPercentiles
+	GetLatency LatencyPercentiles
+}
+
+// codecsUnderTest returns every codec registered via RegisterCodec, in a stable order with
+// "none" first so it reads as the baseline row. Reporting loops drive off this instead of a
+// hardcoded list, so a codec added with RegisterCodec automatically shows up in every report.
+func codecsUnderTest() []Codec {
+	names := RegisteredCodecNames()
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == "none" {
+			return true
+		}
+		if names[j] == "none" {
+			return false
+		}
+		return names[i] < names[j]
+	})
+
+	codecs := make([]Codec, 0, len(names))
+	for _, name := range names {
+		codecs = append(codecs, GetCodec(name))
+	}
+	return codecs
 }
 
 // CompressionBenchmark handles the benchmark execution
 type CompressionBenchmark struct {
 	host string
 	port int
+
+	// results, if non-nil, receives a JSON line per reported row (see -resultFile).
+	results *ResultWriter
 }
 
 // NewCompressionBenchmark creates a new benchmark instance
@@ -45,13 +80,49 @@ func NewCompressionBenchmark() *CompressionBenchmark {
 	}
 }
 
+// recordResult appends a row to the configured -resultFile, if any, stamping it with the
+// current git SHA and host info so two files can be meaningfully compared later.
+func (cb *CompressionBenchmark) recordResult(codecName string, level int, dataset string, entries int, result *BenchmarkResult) {
+	if cb.results == nil {
+		return
+	}
+	row := ResultRow{
+		Timestamp:  time.Now(),
+		Codec:      codecName,
+		Level:      level,
+		Dataset:    dataset,
+		Entries:    entries,
+		OriginalSz: result.OriginalSize,
+		Ratio:      result.CompressionRatio,
+		TPS:        result.TPS,
+		SetOps:     result.SetOps,
+		GetOps:     result.GetOps,
+		SetP50Us:   result.SetLatency.P50,
+		SetP95Us:   result.SetLatency.P95,
+		SetP99Us:   result.SetLatency.P99,
+		SetP999Us:  result.SetLatency.P999,
+		SetMaxUs:   result.SetLatency.Max,
+		GetP50Us:   result.GetLatency.P50,
+		GetP95Us:   result.GetLatency.P95,
+		GetP99Us:   result.GetLatency.P99,
+		GetP999Us:  result.GetLatency.P999,
+		GetMaxUs:   result.GetLatency.Max,
+		GitSHA:     currentGitSHA(),
+		Host:       hostInfo(),
+		GoVersion:  runtime.Version(),
+	}
+	if err := cb.results.Write(row); err != nil {
+		fmt.Printf("⚠️ Failed to write result row: %v\n", err)
+	}
+}
+
 // LoadTestDatasets loads standardized test datasets from files
 func (cb *CompressionBenchmark) LoadTestDatasets() map[string][]string {
 	fmt.Println("📊 Loading standardized test datasets...")
-	
+
 	datasets := make(map[string][]string)
 	dataDir := "../data"
-	
+
 	// Load datasets from standardized files
 	datasets["json_objects"] = cb.loadDatasetFromFile(filepath.Join(dataDir, "json_objects.txt"), false)
 	datasets["app_logs"] = cb.loadDatasetFromFile(filepath.Join(dataDir, "app_logs.txt"), false)
@@ -61,7 +132,7 @@ func (cb *CompressionBenchmark) LoadTestDatasets() map[string][]string {
 	datasets["repetitive"] = cb.loadDatasetFromFile(filepath.Join(dataDir, "repetitive_text.txt"), false)
 	datasets["random"] = cb.loadDatasetFromFile(filepath.Join(dataDir, "random_data.txt"), false)
 	datasets["mixed_web"] = cb.loadDatasetFromFile(filepath.Join(dataDir, "mixed_web.txt"), true) // Mixed web uses --- separator
-	
+
 	// Print dataset info
 	for name, values := range datasets {
 		totalSize := 0
@@ -72,18 +143,18 @@ func (cb *CompressionBenchmark) LoadTestDatasets() map[string][]string {
 		if len(values) > 0 {
 			avgSize = totalSize / len(values)
 		}
-		fmt.Printf("  %-12s: %3d entries, %8d total bytes, %5d avg bytes\n", 
+		fmt.Printf("  %-12s: %3d entries, %8d total bytes, %5d avg bytes\n",
 			name, len(values), totalSize, avgSize)
 	}
 	fmt.Println()
-	
+
 	return datasets
 }
 
 // loadDatasetFromFile loads dataset from a file
 func (cb *CompressionBenchmark) loadDatasetFromFile(filename string, useSeparator bool) []string {
 	var entries []string
-	
+
 	file, err := os.Open(filename)
 	if err != nil {
 		fmt.Printf("⚠️ Failed to load %s: %v\n", filename, err)
@@ -91,7 +162,7 @@ func (cb *CompressionBenchmark) loadDatasetFromFile(filename string, useSeparato
 		return cb.generateFallbackData(filepath.Base(filename))
 	}
 	defer file.Close()
-	
+
 	// Read entire file content
 	content := strings.Builder{}
 	scanner := bufio.NewScanner(file)
@@ -99,14 +170,14 @@ func (cb *CompressionBenchmark) loadDatasetFromFile(filename string, useSeparato
 		content.WriteString(scanner.Text())
 		content.WriteString("\n")
 	}
-	
+
 	if err := scanner.Err(); err != nil {
 		fmt.Printf("⚠️ Failed to read %s: %v\n", filename, err)
 		return cb.generateFallbackData(filepath.Base(filename))
 	}
-	
+
 	contentStr := content.String()
-	
+
 	if useSeparator {
 		// Split by --- separator for XML and mixed web content
 		parts := strings.Split(contentStr, "---")
@@ -126,7 +197,7 @@ func (cb *CompressionBenchmark) loadDatasetFromFile(filename string, useSeparato
 			}
 		}
 	}
-	
+
 	return entries
 }
 
@@ -139,32 +210,33 @@ func (cb *CompressionBenchmark) generateFallbackData(filename string) []string {
 	return fallback
 }
 
-// BenchmarkCompressionLevels benchmarks different compression levels
+// BenchmarkCompressionLevels benchmarks different compression levels of the zstd codec
 func (cb *CompressionBenchmark) BenchmarkCompressionLevels(datasets map[string][]string) error {
 	fmt.Println("🎚️ Compression Level Benchmark")
 	fmt.Println(strings.Repeat("-", 80))
-	fmt.Printf("%-6s %-12s %-10s %-10s %-8s %-8s\n", 
+	fmt.Printf("%-6s %-12s %-10s %-10s %-8s %-8s\n",
 		"Level", "Dataset", "Original", "Compressed", "Ratio", "TPS")
 	fmt.Println(strings.Repeat("-", 80))
-	
+
 	levels := []int{1, 3, 6, 9, 15, 22}
 	testDataset := datasets["json_objects"] // Use JSON for level testing
-	
+
 	for _, level := range levels {
+		codec := (&zstdCodec{}).WithLevel(level)
 		result, err := cb.benchmarkConfiguration(
-			true, level, // Use compression simulation with specific level
-			fmt.Sprintf("json_objects_level_%d", level), testDataset)
+			codec, fmt.Sprintf("json_objects_level_%d", level), testDataset)
 		if err != nil {
 			return fmt.Errorf("failed to benchmark level %d: %w", level, err)
 		}
-		
+		cb.recordResult(codec.Name(), level, "json_objects", len(testDataset), result)
+
 		originalSize := 0
 		for _, data := range testDataset {
 			originalSize += len(data)
 		}
-		
+
 		fmt.Printf("%-6d %-12s %10d %10d %-8.2f %8.0f\n",
-			level, "json_objects", 
+			level, "json_objects",
 			originalSize, result.CompressedSize,
 			result.CompressionRatio, result.TPS)
 	}
@@ -172,61 +244,80 @@ func (cb *CompressionBenchmark) BenchmarkCompressionLevels(datasets map[string][
 	return nil
 }
 
-// BenchmarkDataTypes benchmarks different data types
+// BenchmarkDataTypes benchmarks every registered codec against each data type
 func (cb *CompressionBenchmark) BenchmarkDataTypes(datasets map[string][]string) error {
 	fmt.Println("📋 Data Type Compression Effectiveness")
 	fmt.Println("   Measures compression ratio and memory savings for different data types")
 	fmt.Println(strings.Repeat("-", 80))
-	fmt.Printf("%-12s %-8s %-10s %-10s %-8s %-8s %-10s\n", 
-		"Dataset", "Entries", "Original", "Redis Mem", "Ratio", "TPS", "Savings")
+	fmt.Printf("%-12s %-8s %-8s %-10s %-10s %-8s %-8s %-10s\n",
+		"Dataset", "Codec", "Entries", "Original", "Compressed", "Ratio", "TPS", "Savings")
 	fmt.Println(strings.Repeat("-", 80))
-	
+
 	for datasetName, data := range datasets {
-		result, err := cb.benchmarkConfiguration(true, 3, datasetName, data) // Use level 3 as default
-		if err != nil {
-			return fmt.Errorf("failed to benchmark dataset %s: %w", datasetName, err)
-		}
-		
-		originalSize := 0
-		for _, entry := range data {
-			originalSize += len(entry)
+		for _, codec := range codecsUnderTest() {
+			result, err := cb.benchmarkConfiguration(codec, datasetName+"_"+codec.Name(), data)
+			if err != nil {
+				return fmt.Errorf("failed to benchmark dataset %s with codec %s: %w", datasetName, codec.Name(), err)
+			}
+			cb.recordResult(codec.Name(), 0, datasetName, len(data), result)
+
+			originalSize := 0
+			for _, entry := range data {
+				originalSize += len(entry)
+			}
+			savings := float64(originalSize-int(result.CompressedSize)) / float64(originalSize) * 100
+
+			fmt.Printf("%-12s %-8s %8d %10d %10d %-8.2f %8.0f %9.1f%%\n",
+				datasetName, codec.Name(), len(data), originalSize, result.CompressedSize,
+				result.CompressionRatio, result.TPS, savings)
 		}
-		savings := float64(originalSize-int(result.CompressedSize)) / float64(originalSize) * 100
-		
-		fmt.Printf("%-12s %8d %10d %10d %-8.2f %8.0f %9.1f%%\n",
-			datasetName, len(data), originalSize, result.CompressedSize,
-			result.CompressionRatio, result.TPS, savings)
 	}
 	fmt.Println()
 	return nil
 }
 
-// BenchmarkThroughput benchmarks throughput with different configurations
+// BenchmarkThroughput benchmarks throughput for every registered codec against each dataset
 func (cb *CompressionBenchmark) BenchmarkThroughput(datasets map[string][]string) error {
 	fmt.Println("⚡ Throughput Benchmark (Operations/Second)")
 	fmt.Println("   Measures performance impact of compression on SET/GET operations")
 	fmt.Println(strings.Repeat("-", 80))
-	fmt.Printf("%-12s %-12s %-12s %-12s\n", 
-		"Dataset", "Uncompressed", "Compressed", "Perf Impact")
+	fmt.Printf("%-12s %-8s %-12s %-12s %-12s\n",
+		"Dataset", "Codec", "Uncompressed", "Compressed", "Perf Impact")
 	fmt.Println(strings.Repeat("-", 80))
-	
+
 	for datasetName, data := range datasets {
-		// Benchmark uncompressed
-		uncompressed, err := cb.benchmarkConfiguration(false, 0, datasetName+"_uncomp", data)
+		// Benchmark uncompressed baseline once per dataset
+		uncompressed, err := cb.benchmarkConfiguration(&noneCodec{}, datasetName+"_uncomp", data)
 		if err != nil {
 			return fmt.Errorf("failed to benchmark uncompressed %s: %w", datasetName, err)
 		}
-		
-		// Benchmark compressed
-		compressed, err := cb.benchmarkConfiguration(true, 3, datasetName+"_comp", data) // Use level 3
-		if err != nil {
-			return fmt.Errorf("failed to benchmark compressed %s: %w", datasetName, err)
+
+		codecs := codecsUnderTest()
+		originalSize := 0
+		for _, entry := range data {
+			originalSize += len(entry)
+		}
+		if originalSize/max(1, len(data)) >= DefaultChunkedThreshold {
+			// Large average payload: show whether parallel chunked compression beats
+			// single-threaded zstd, i.e. the crossover point mentioned above.
+			codecs = append(codecs, NewChunkedCodec((&zstdCodec{}).WithLevel(3)))
+		}
+
+		for _, codec := range codecs {
+			if codec.Name() == "none" {
+				continue
+			}
+			compressed, err := cb.benchmarkConfiguration(codec, datasetName+"_"+codec.Name(), data)
+			if err != nil {
+				return fmt.Errorf("failed to benchmark %s with codec %s: %w", datasetName, codec.Name(), err)
+			}
+			cb.recordResult(codec.Name(), 0, datasetName, len(data), compressed)
+
+			difference := (compressed.TPS - uncompressed.TPS) / uncompressed.TPS * 100
+
+			fmt.Printf("%-12s %-8s %12.0f %12.0f %+11.1f%%\n",
+				datasetName, codec.Name(), uncompressed.TPS, compressed.TPS, difference)
 		}
-		
-		difference := (compressed.TPS - uncompressed.TPS) / uncompressed.TPS * 100
-		
-		fmt.Printf("%-12s %12.0f %12.0f %+11.1f%%\n",
-			datasetName, uncompressed.TPS, compressed.TPS, difference)
 	}
 	fmt.Println()
 	return nil
@@ -236,33 +327,33 @@ func (cb *CompressionBenchmark) BenchmarkThroughput(datasets map[string][]string
 func (cb *CompressionBenchmark) BenchmarkMemoryEfficiency(datasets map[string][]string) error {
 	fmt.Println("💾 Memory Efficiency Benchmark")
 	fmt.Println(strings.Repeat("-", 80))
-	fmt.Printf("%-12s %-8s %-10s %-10s %-10s %-10s\n", 
+	fmt.Printf("%-12s %-8s %-10s %-10s %-10s %-10s\n",
 		"Dataset", "Entries", "Original", "Uncomp Mem", "Comp Mem", "Mem Saved")
 	fmt.Println(strings.Repeat("-", 80))
-	
+
 	for datasetName, data := range datasets {
 		// Test uncompressed memory usage
-		uncompressedMemory, err := cb.measureMemoryUsage(false, 0, datasetName+"_mem_uncomp", data)
+		uncompressedMemory, err := cb.measureMemoryUsage(&noneCodec{}, datasetName+"_mem_uncomp", data)
 		if err != nil {
 			return fmt.Errorf("failed to measure uncompressed memory for %s: %w", datasetName, err)
 		}
-		
+
 		// Test compressed memory usage
-		compressedMemory, err := cb.measureMemoryUsage(true, 3, datasetName+"_mem_comp", data) // Use level 3
+		compressedMemory, err := cb.measureMemoryUsage((&zstdCodec{}).WithLevel(3), datasetName+"_mem_comp", data)
 		if err != nil {
 			return fmt.Errorf("failed to measure compressed memory for %s: %w", datasetName, err)
 		}
-		
+
 		originalSize := 0
 		for _, entry := range data {
 			originalSize += len(entry)
 		}
-		
+
 		memorySaved := 0.0
 		if uncompressedMemory > 0 {
 			memorySaved = float64(uncompressedMemory-compressedMemory) / float64(uncompressedMemory) * 100
 		}
-		
+
 		fmt.Printf("%-12s %8d %10d %10d %10d %9.1f%%\n",
 			datasetName, len(data), originalSize, uncompressedMemory, compressedMemory, memorySaved)
 	}
@@ -270,222 +361,163 @@ func (cb *CompressionBenchmark) BenchmarkMemoryEfficiency(datasets map[string][]
 	return nil
 }
 
-// benchmarkConfiguration benchmarks a specific configuration
-func (cb *CompressionBenchmark) benchmarkConfiguration(useCompression bool, compressionLevel int,
+// benchmarkConfiguration drives SET/GET through the given codec, compressing each value
+// before SET and decompressing after GET, and verifies the round trip holds.
+func (cb *CompressionBenchmark) benchmarkConfiguration(codec Codec,
 	keyPrefix string, dataList []string) (*BenchmarkResult, error) {
-	
+
 	// Connect to Redis
 	rdb := redis.NewClient(&redis.Options{
 		Addr: fmt.Sprintf("%s:%d", cb.host, cb.port),
 	})
 	defer rdb.Close()
-	
+
 	ctx := context.Background()
-	
+
 	// Test connection
 	_, err := rdb.Ping(ctx).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
-	
+
 	// Warmup - use different entries for each warmup iteration
 	for i := 0; i < WARMUP_ITERATIONS; i++ {
 		data := dataList[i%len(dataList)]
-		key := fmt.Sprintf("%s_warmup_%d", keyPrefix, i)
-		err := rdb.Set(ctx, key, data, time.Hour).Err()
+		compressed, err := codec.Compress(nil, []byte(data))
 		if err != nil {
+			return nil, fmt.Errorf("warmup compression failed: %w", err)
+		}
+		key := fmt.Sprintf("%s_warmup_%d", keyPrefix, i)
+		if err := rdb.Set(ctx, key, compressed, time.Hour).Err(); err != nil {
 			return nil, fmt.Errorf("warmup failed: %w", err)
 		}
 	}
-	
-	// Measure TPS
+
+	// Measure TPS and per-operation latency
+	setHistogram := NewHistogram()
+	getHistogram := NewHistogram()
+
 	startTime := time.Now()
 	operations := 0
-	
+	var setOps, getOps int64
+
 	for time.Since(startTime).Milliseconds() < TPS_TEST_DURATION_MS {
 		data := dataList[operations%len(dataList)]
 		key := fmt.Sprintf("%s_tps_%d", keyPrefix, operations)
-		
-		err := rdb.Set(ctx, key, data, time.Hour).Err()
+
+		compressed, err := codec.Compress(nil, []byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("TPS test compression failed: %w", err)
+		}
+
+		setStart := time.Now()
+		err = rdb.Set(ctx, key, compressed, time.Hour).Err()
+		setHistogram.RecordMicros(time.Since(setStart).Microseconds())
 		if err != nil {
 			return nil, fmt.Errorf("TPS test SET failed: %w", err)
 		}
-		
-		_, err = rdb.Get(ctx, key).Result()
+		setOps++
+
+		getStart := time.Now()
+		raw, err := rdb.Get(ctx, key).Bytes()
+		getHistogram.RecordMicros(time.Since(getStart).Microseconds())
 		if err != nil && err != redis.Nil {
 			return nil, fmt.Errorf("TPS test GET failed: %w", err)
 		}
-		
+		getOps++
+		if _, err := codec.Decompress(nil, raw); err != nil {
+			return nil, fmt.Errorf("TPS test decompression failed: %w", err)
+		}
+
 		operations += 2 // SET + GET
 	}
-	
+
 	endTime := time.Now()
-	baseTPS := float64(operations) / endTime.Sub(startTime).Seconds()
-	
-	// Apply compression level performance penalty
-	tps := baseTPS
-	if useCompression {
-		tps = cb.applyCompressionLevelPenalty(baseTPS, compressionLevel)
-	}
-	
-	// Measure compression across all entries
+	tps := float64(operations) / endTime.Sub(startTime).Seconds()
+
+	// Compress every entry, verify round-trip equality, and derive the real compressed size.
 	var totalCompressedSize int64
 	originalSize := 0
-	
+
 	for i, data := range dataList {
-		testKey := fmt.Sprintf("%s_size_test_%d", keyPrefix, i)
-		err := rdb.Set(ctx, testKey, data, time.Hour).Err()
+		compressed, err := codec.Compress(nil, []byte(data))
 		if err != nil {
-			return nil, fmt.Errorf("compression test SET failed: %w", err)
+			return nil, fmt.Errorf("compression test failed: %w", err)
 		}
-		
-		memUsage, err := cb.getMemoryUsage(testKey, rdb, ctx)
+
+		decompressed, err := codec.Decompress(nil, compressed)
 		if err != nil {
-			// Silently continue if memory usage can't be measured
-			memUsage = int64(len(data))
+			return nil, fmt.Errorf("round-trip decompression failed: %w", err)
+		}
+		if string(decompressed) != data {
+			return nil, fmt.Errorf("round-trip mismatch for codec %s at entry %d", codec.Name(), i)
 		}
-		totalCompressedSize += memUsage
+
+		testKey := fmt.Sprintf("%s_size_test_%d", keyPrefix, i)
+		if err := rdb.Set(ctx, testKey, compressed, time.Hour).Err(); err != nil {
+			return nil, fmt.Errorf("compression test SET failed: %w", err)
+		}
+
+		totalCompressedSize += int64(len(compressed))
 		originalSize += len(data)
 	}
-	
-	// Handle case where memory usage couldn't be measured
-	if totalCompressedSize == 0 {
-		totalCompressedSize = int64(originalSize) // Fallback to original size
-	}
-	
-	// Simulate compression ratio based on data type and compression setting
-	var compressionRatio float64
-	if !useCompression {
-		compressionRatio = 1.0 // No compression applied
-	} else {
-		// Simulate compression ratios based on data characteristics and level
-		compressionRatio = cb.simulateCompressionRatio(keyPrefix, originalSize, compressionLevel)
-		// Adjust compressed size based on simulated ratio
-		totalCompressedSize = int64(float64(originalSize) / compressionRatio)
+
+	compressionRatio := 1.0
+	if totalCompressedSize > 0 {
+		compressionRatio = float64(originalSize) / float64(totalCompressedSize)
 	}
-	
+
 	return &BenchmarkResult{
 		TPS:              tps,
+		OriginalSize:     int64(originalSize),
 		CompressedSize:   totalCompressedSize,
 		CompressionRatio: compressionRatio,
+		SetOps:           setOps,
+		GetOps:           getOps,
+		SetLatency:       setHistogram.Snapshot(),
+		GetLatency:       getHistogram.Snapshot(),
 	}, nil
 }
 
-// measureMemoryUsage measures memory usage for a configuration
-func (cb *CompressionBenchmark) measureMemoryUsage(useCompression bool, compressionLevel int,
-	keyPrefix string, dataList []string) (int64, error) {
-	
+// measureMemoryUsage measures server-side memory usage for values stored under the given codec
+func (cb *CompressionBenchmark) measureMemoryUsage(codec Codec, keyPrefix string, dataList []string) (int64, error) {
 	// Connect to Redis
 	rdb := redis.NewClient(&redis.Options{
 		Addr: fmt.Sprintf("%s:%d", cb.host, cb.port),
 	})
 	defer rdb.Close()
-	
+
 	ctx := context.Background()
-	
+
 	// Test connection
 	_, err := rdb.Ping(ctx).Result()
 	if err != nil {
 		return 0, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
-	
+
 	var totalMemory int64
-	
+
 	for i, data := range dataList {
-		key := fmt.Sprintf("%s_%d", keyPrefix, i)
-		err := rdb.Set(ctx, key, data, time.Hour).Err()
+		compressed, err := codec.Compress(nil, []byte(data))
 		if err != nil {
+			return 0, fmt.Errorf("memory test compression failed: %w", err)
+		}
+
+		key := fmt.Sprintf("%s_%d", keyPrefix, i)
+		if err := rdb.Set(ctx, key, compressed, time.Hour).Err(); err != nil {
 			return 0, fmt.Errorf("memory test SET failed: %w", err)
 		}
-		
+
 		memUsage, err := cb.getMemoryUsage(key, rdb, ctx)
 		if err != nil {
-			// Use data length as fallback
-			memUsage = int64(len(data))
+			// Use compressed length as fallback
+			memUsage = int64(len(compressed))
 		}
-		
-		// Simulate compression effect on memory usage
-		if useCompression {
-			compressionRatio := cb.simulateCompressionRatio(keyPrefix, len(data), compressionLevel)
-			memUsage = int64(float64(memUsage) / compressionRatio)
-		}
-		
-		totalMemory += memUsage
-	}
-	
-	return totalMemory, nil
-}
 
-// simulateCompressionRatio simulates compression ratios based on data type and level
-func (cb *CompressionBenchmark) simulateCompressionRatio(keyPrefix string, dataSize int, compressionLevel int) float64 {
-	// Base compression ratios by data type
-	var baseRatio float64
-	switch {
-	case strings.Contains(keyPrefix, "json"):
-		baseRatio = 2.5 // JSON compresses well
-	case strings.Contains(keyPrefix, "xml"):
-		baseRatio = 3.0 // XML has lots of repetitive tags
-	case strings.Contains(keyPrefix, "csv"):
-		baseRatio = 2.2 // CSV has some repetition
-	case strings.Contains(keyPrefix, "repetitive"):
-		baseRatio = 8.0 // Highly repetitive text compresses very well
-	case strings.Contains(keyPrefix, "app_logs"):
-		baseRatio = 2.8 // Log files compress well due to patterns
-	case strings.Contains(keyPrefix, "base64"):
-		baseRatio = 1.3 // Base64 doesn't compress much
-	case strings.Contains(keyPrefix, "random"):
-		baseRatio = 1.1 // Random data barely compresses
-	case strings.Contains(keyPrefix, "mixed_web"):
-		baseRatio = 2.4 // Mixed web content compresses moderately well
-	default:
-		baseRatio = 2.0 // Default compression ratio
-	}
-	
-	// Adjust ratio based on compression level
-	// Higher levels achieve better compression but with diminishing returns
-	levelMultiplier := 1.0
-	switch compressionLevel {
-	case 1:
-		levelMultiplier = 0.85 // Level 1: fast, lower compression
-	case 3:
-		levelMultiplier = 1.0  // Level 3: balanced (baseline)
-	case 6:
-		levelMultiplier = 1.15 // Level 6: better compression
-	case 9:
-		levelMultiplier = 1.25 // Level 9: good compression
-	case 15:
-		levelMultiplier = 1.35 // Level 15: high compression
-	case 22:
-		levelMultiplier = 1.4  // Level 22: maximum compression
-	default:
-		levelMultiplier = 1.0
+		totalMemory += memUsage
 	}
-	
-	return baseRatio * levelMultiplier
-}
 
-// applyCompressionLevelPenalty applies performance penalty based on compression level
-func (cb *CompressionBenchmark) applyCompressionLevelPenalty(baseTPS float64, compressionLevel int) float64 {
-	// Higher compression levels are slower due to more CPU intensive algorithms
-	var penalty float64
-	switch compressionLevel {
-	case 1:
-		penalty = 0.95 // Level 1: minimal penalty (5% slower)
-	case 3:
-		penalty = 0.90 // Level 3: small penalty (10% slower)
-	case 6:
-		penalty = 0.80 // Level 6: moderate penalty (20% slower)
-	case 9:
-		penalty = 0.65 // Level 9: significant penalty (35% slower)
-	case 15:
-		penalty = 0.45 // Level 15: high penalty (55% slower)
-	case 22:
-		penalty = 0.30 // Level 22: maximum penalty (70% slower)
-	default:
-		penalty = 0.90
-	}
-	
-	return baseTPS * penalty
+	return totalMemory, nil
 }
 
 // getMemoryUsage gets memory usage for a key
@@ -494,11 +526,11 @@ func (cb *CompressionBenchmark) getMemoryUsage(key string, client *redis.Client,
 	if err != nil {
 		return 0, err
 	}
-	
+
 	if result == nil {
 		return 0, nil
 	}
-	
+
 	// Handle different result types
 	switch v := result.(type) {
 	case int64:
@@ -524,34 +556,58 @@ func (cb *CompressionBenchmark) RunBenchmark() error {
 	fmt.Printf("Benchmark iterations: %d\n", BENCHMARK_ITERATIONS)
 	fmt.Printf("TPS test duration: %dms\n", TPS_TEST_DURATION_MS)
 	fmt.Println()
-	
+
 	// Load standardized test datasets
 	datasets := cb.LoadTestDatasets()
-	
+
 	// Run comprehensive benchmarks
 	if err := cb.BenchmarkCompressionLevels(datasets); err != nil {
 		return fmt.Errorf("compression levels benchmark failed: %w", err)
 	}
-	
+
 	if err := cb.BenchmarkDataTypes(datasets); err != nil {
 		return fmt.Errorf("data types benchmark failed: %w", err)
 	}
-	
+
 	if err := cb.BenchmarkThroughput(datasets); err != nil {
 		return fmt.Errorf("throughput benchmark failed: %w", err)
 	}
-	
+
 	if err := cb.BenchmarkMemoryEfficiency(datasets); err != nil {
 		return fmt.Errorf("memory efficiency benchmark failed: %w", err)
 	}
-	
+
+	if err := cb.BenchmarkDictionaryImpact(datasets); err != nil {
+		return fmt.Errorf("dictionary impact benchmark failed: %w", err)
+	}
+
+	if err := cb.BenchmarkAdaptive(datasets); err != nil {
+		return fmt.Errorf("adaptive compression benchmark failed: %w", err)
+	}
+
+	if err := cb.BenchmarkZstdImplementations(datasets); err != nil {
+		return fmt.Errorf("zstd implementation benchmark failed: %w", err)
+	}
+
 	fmt.Println("\n🎉 Benchmark completed successfully!")
 	return nil
 }
 
 func main() {
+	resultFile := flag.String("resultFile", "", "if set, append each benchmark row to this file as JSON lines")
+	flag.Parse()
+
 	benchmark := NewCompressionBenchmark()
-	
+
+	if *resultFile != "" {
+		writer, err := NewResultWriter(*resultFile)
+		if err != nil {
+			log.Fatalf("❌ Error opening result file: %v", err)
+		}
+		defer writer.Close()
+		benchmark.results = writer
+	}
+
 	if err := benchmark.RunBenchmark(); err != nil {
 		log.Fatalf("❌ Error during benchmark: %v", err)
 	}
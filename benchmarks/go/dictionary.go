@@ -0,0 +1,287 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// DefaultDictionarySamples caps how many entries are sampled when training a dictionary.
+	DefaultDictionarySamples = 500
+
+	// DefaultSegmentSize is the size, in bytes, of each segment selected from the samples.
+	DefaultSegmentSize = 128
+
+	// DefaultSegmentCount is how many segments are concatenated into the trained dictionary.
+	DefaultSegmentCount = 64
+
+	// MinCompressSize is the smallest value, in bytes, worth attempting to compress at all.
+	// Values below this are stored raw and logged as skipped.
+	MinCompressSize = 32
+)
+
+// DictionaryTrainer builds a zstd dictionary from representative samples of a dataset so that
+// small, structurally similar values (JSON records, log lines, CSV rows) compress far better
+// than they would against a cold, history-less zstd model.
+type DictionaryTrainer struct {
+	// MaxSamples bounds how many entries are drawn from the dataset for training.
+	MaxSamples int
+
+	// SegmentSize is the size of each coverage segment selected from the samples.
+	SegmentSize int
+
+	// SegmentCount is how many segments are concatenated to build the dictionary content.
+	SegmentCount int
+}
+
+// NewDictionaryTrainer returns a DictionaryTrainer configured with the package defaults.
+func NewDictionaryTrainer() *DictionaryTrainer {
+	return &DictionaryTrainer{
+		MaxSamples:   DefaultDictionarySamples,
+		SegmentSize:  DefaultSegmentSize,
+		SegmentCount: DefaultSegmentCount,
+	}
+}
+
+// Train builds a zstd dictionary from up to MaxSamples entries of the dataset. It selects
+// SegmentCount segments of SegmentSize bytes that maximize coverage of distinct substrings
+// across the samples (a simplified cover-style selection) and concatenates them as the
+// dictionary content. This is raw content, not a spec-compliant .zdict with entropy tables -
+// callers must load it with WithEncoderDictRaw/WithDecoderDictRaw, keyed by DictionaryID.
+func (t *DictionaryTrainer) Train(dataset string, samples []string) ([]byte, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("dictionary training: %s has no samples", dataset)
+	}
+
+	limited := samples
+	if len(limited) > t.MaxSamples {
+		limited = limited[:t.MaxSamples]
+	}
+
+	segments := t.selectSegments(limited)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("dictionary training: %s produced no usable segments", dataset)
+	}
+
+	content := make([]byte, 0, len(segments)*t.SegmentSize)
+	for _, seg := range segments {
+		content = append(content, seg...)
+	}
+
+	return content, nil
+}
+
+// DictionaryID derives the raw-content dictionary ID zstd uses to match an encoder's dictionary
+// to a decoder's, from the dataset name.
+func DictionaryID(dataset string) uint32 {
+	return fnv32(dataset)
+}
+
+// selectSegments picks up to SegmentCount non-overlapping windows of SegmentSize bytes from the
+// samples, scored by how many distinct trigrams (a cheap proxy for suffix-array coverage) each
+// window contributes that haven't already been covered by a previously chosen segment.
+func (t *DictionaryTrainer) selectSegments(samples []string) [][]byte {
+	type candidate struct {
+		bytes    []byte
+		trigrams map[string]struct{}
+	}
+
+	var candidates []candidate
+	for _, s := range samples {
+		data := []byte(s)
+		if len(data) < t.SegmentSize {
+			continue
+		}
+		for start := 0; start+t.SegmentSize <= len(data); start += t.SegmentSize {
+			window := data[start : start+t.SegmentSize]
+			candidates = append(candidates, candidate{
+				bytes:    window,
+				trigrams: trigramSet(window),
+			})
+		}
+	}
+
+	covered := make(map[string]struct{})
+	var chosen [][]byte
+
+	for len(chosen) < t.SegmentCount && len(candidates) > 0 {
+		bestIdx := -1
+		bestGain := -1
+		for i, c := range candidates {
+			gain := 0
+			for g := range c.trigrams {
+				if _, ok := covered[g]; !ok {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				bestGain = gain
+				bestIdx = i
+			}
+		}
+		if bestIdx == -1 || bestGain <= 0 {
+			break
+		}
+		chosen = append(chosen, candidates[bestIdx].bytes)
+		for g := range candidates[bestIdx].trigrams {
+			covered[g] = struct{}{}
+		}
+		candidates = append(candidates[:bestIdx], candidates[bestIdx+1:]...)
+	}
+
+	// If coverage-based selection ran dry (e.g. highly repetitive data), pad with whatever
+	// segments remain so the dictionary still reaches a useful size.
+	sort.Slice(candidates, func(i, j int) bool { return len(candidates[i].bytes) > len(candidates[j].bytes) })
+	for len(chosen) < t.SegmentCount && len(candidates) > 0 {
+		chosen = append(chosen, candidates[0].bytes)
+		candidates = candidates[1:]
+	}
+
+	return chosen
+}
+
+func trigramSet(data []byte) map[string]struct{} {
+	set := make(map[string]struct{})
+	for i := 0; i+3 <= len(data); i++ {
+		set[string(data[i:i+3])] = struct{}{}
+	}
+	return set
+}
+
+func fnv32(s string) uint32 {
+	const prime = 16777619
+	hash := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		hash ^= uint32(s[i])
+		hash *= prime
+	}
+	return hash
+}
+
+// SaveDictionary persists a trained dictionary under ../data/dicts/<dataset>.zdict.
+func SaveDictionary(dataset string, dict []byte) (string, error) {
+	dir := filepath.Join("..", "data", "dicts")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create dictionary directory: %w", err)
+	}
+	path := filepath.Join(dir, dataset+".zdict")
+	if err := os.WriteFile(path, dict, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write dictionary for %s: %w", dataset, err)
+	}
+	return path, nil
+}
+
+// LoadDictionary reads a previously trained dictionary for dataset, if one exists on disk.
+func LoadDictionary(dataset string) ([]byte, error) {
+	path := filepath.Join("..", "data", "dicts", dataset+".zdict")
+	return os.ReadFile(path)
+}
+
+// zstdDictCodec wraps zstdCodec with an encoder/decoder dictionary. dict is raw content (the
+// trainer's output has no entropy tables), so it is loaded via the *DictRaw options with id
+// keying the encoder's dictionary to the decoder's rather than parsing a zstd dictionary header.
+type zstdDictCodec struct {
+	level zstd.EncoderLevel
+	id    uint32
+	dict  []byte
+}
+
+func (c *zstdDictCodec) Name() string { return "zstd-dict" }
+
+func (c *zstdDictCodec) Compress(dst, src []byte) ([]byte, error) {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(c.level), zstd.WithEncoderDictRaw(c.id, c.dict))
+	if err != nil {
+		return nil, fmt.Errorf("zstd-dict: failed to create encoder: %w", err)
+	}
+	defer enc.Close()
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (c *zstdDictCodec) Decompress(dst, src []byte) ([]byte, error) {
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDictRaw(c.id, c.dict))
+	if err != nil {
+		return nil, fmt.Errorf("zstd-dict: failed to create decoder: %w", err)
+	}
+	defer dec.Close()
+	out, err := dec.DecodeAll(src, dst)
+	if err != nil {
+		return nil, fmt.Errorf("zstd-dict: decompress failed: %w", err)
+	}
+	return out, nil
+}
+
+// BenchmarkDictionaryImpact runs each dataset three ways - uncompressed, zstd level 3 without a
+// dictionary, and zstd level 3 with a dictionary trained on that dataset - and reports ratio and
+// TPS side-by-side so users can see when the dictionary is worth its memory cost.
+func (cb *CompressionBenchmark) BenchmarkDictionaryImpact(datasets map[string][]string) error {
+	fmt.Println("📖 Dictionary Impact Benchmark")
+	fmt.Println("   Compares zstd with and without a dictionary trained on each dataset")
+	fmt.Println(strings.Repeat("-", 80))
+	fmt.Printf("%-12s %-14s %-10s %-8s %-8s\n", "Dataset", "Mode", "Compressed", "Ratio", "TPS")
+	fmt.Println(strings.Repeat("-", 80))
+
+	trainer := NewDictionaryTrainer()
+
+	for datasetName, data := range datasets {
+		var usable []string
+		var skipped int
+		for _, entry := range data {
+			if len(entry) < MinCompressSize {
+				skipped++
+				continue
+			}
+			usable = append(usable, entry)
+		}
+		if skipped > 0 {
+			fmt.Printf("  (%d entries in %s are below MinCompressSize=%d bytes and were left uncompressed)\n",
+				skipped, datasetName, MinCompressSize)
+		}
+		if len(usable) == 0 {
+			continue
+		}
+
+		uncompressed, err := cb.benchmarkConfiguration(&noneCodec{}, datasetName+"_dict_none", usable)
+		if err != nil {
+			return fmt.Errorf("failed to benchmark uncompressed %s: %w", datasetName, err)
+		}
+		cb.recordResult("none", 0, datasetName, len(usable), uncompressed)
+		fmt.Printf("%-12s %-14s %10d %-8.2f %8.0f\n",
+			datasetName, "uncompressed", uncompressed.CompressedSize, uncompressed.CompressionRatio, uncompressed.TPS)
+
+		noDict := (&zstdCodec{}).WithLevel(3)
+		noDictResult, err := cb.benchmarkConfiguration(noDict, datasetName+"_dict_nodict", usable)
+		if err != nil {
+			return fmt.Errorf("failed to benchmark zstd-no-dict %s: %w", datasetName, err)
+		}
+		cb.recordResult("zstd-nodict", 3, datasetName, len(usable), noDictResult)
+		fmt.Printf("%-12s %-14s %10d %-8.2f %8.0f\n",
+			datasetName, "zstd-3-nodict", noDictResult.CompressedSize, noDictResult.CompressionRatio, noDictResult.TPS)
+
+		dict, err := trainer.Train(datasetName, usable)
+		if err != nil {
+			fmt.Printf("  (skipping dictionary for %s: %v)\n", datasetName, err)
+			continue
+		}
+		if _, err := SaveDictionary(datasetName, dict); err != nil {
+			fmt.Printf("  (failed to persist dictionary for %s: %v)\n", datasetName, err)
+		}
+
+		withDict := &zstdDictCodec{level: zstdLevelFromInt(3), id: DictionaryID(datasetName), dict: dict}
+		withDictResult, err := cb.benchmarkConfiguration(withDict, datasetName+"_dict_withdict", usable)
+		if err != nil {
+			return fmt.Errorf("failed to benchmark zstd-with-dict %s: %w", datasetName, err)
+		}
+		cb.recordResult("zstd-dict", 3, datasetName, len(usable), withDictResult)
+		fmt.Printf("%-12s %-14s %10d %-8.2f %8.0f\n",
+			datasetName, "zstd-3-dict", withDictResult.CompressedSize, withDictResult.CompressionRatio, withDictResult.TPS)
+	}
+	fmt.Println()
+	return nil
+}
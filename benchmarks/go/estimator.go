@@ -0,0 +1,207 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Framing byte prefixed to every value stored through CompressibilityEstimator, identifying
+// how to decode it.
+const (
+	FrameRaw  byte = 0x00
+	FrameZstd byte = 0x01
+	FrameGzip byte = 0x02
+)
+
+const (
+	// entropySamplePrefix is how much of a value is sampled to estimate compressibility.
+	entropySamplePrefix = 4 * 1024
+
+	// DefaultMinRatio is the predicted compression ratio below which a value is stored raw.
+	DefaultMinRatio = 1.10
+)
+
+// CompressibilityEstimator predicts, cheaply, whether compressing a value is worth the CPU
+// cost: it computes the Shannon entropy of a sampled prefix and derives a rough best-case
+// compression ratio from it (an 8-bit-per-byte theoretical Huffman lower bound), then compares
+// that prediction against MinRatio.
+type CompressibilityEstimator struct {
+	// MinRatio is the predicted ratio below which a value is marked "store raw". Defaults to
+	// DefaultMinRatio.
+	MinRatio float64
+
+	// SamplePrefix bounds how many leading bytes are sampled for entropy estimation. Defaults
+	// to entropySamplePrefix.
+	SamplePrefix int
+}
+
+// NewCompressibilityEstimator returns an estimator configured with the package defaults.
+func NewCompressibilityEstimator() *CompressibilityEstimator {
+	return &CompressibilityEstimator{
+		MinRatio:     DefaultMinRatio,
+		SamplePrefix: entropySamplePrefix,
+	}
+}
+
+// ShouldCompress reports whether value is predicted to compress by at least MinRatio.
+func (e *CompressibilityEstimator) ShouldCompress(value []byte) bool {
+	return e.PredictRatio(value) >= e.minRatio()
+}
+
+func (e *CompressibilityEstimator) minRatio() float64 {
+	if e.MinRatio <= 0 {
+		return DefaultMinRatio
+	}
+	return e.MinRatio
+}
+
+func (e *CompressibilityEstimator) samplePrefix() int {
+	if e.SamplePrefix <= 0 {
+		return entropySamplePrefix
+	}
+	return e.SamplePrefix
+}
+
+// PredictRatio estimates the best-case compression ratio for value from the Shannon entropy
+// of a sampled prefix: a source with H bits of entropy per byte cannot, on average, be packed
+// below H/8 of its original size, so the predicted ratio is 8/H.
+func (e *CompressibilityEstimator) PredictRatio(value []byte) float64 {
+	if len(value) == 0 {
+		return 1.0
+	}
+	prefix := value
+	if len(prefix) > e.samplePrefix() {
+		prefix = prefix[:e.samplePrefix()]
+	}
+
+	entropy := shannonEntropy(prefix)
+	if entropy <= 0 {
+		// Degenerate case: a single repeated byte has zero entropy and compresses extremely well.
+		return math.Inf(1)
+	}
+	return 8.0 / entropy
+}
+
+func shannonEntropy(data []byte) float64 {
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	n := float64(len(data))
+	var entropy float64
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// FramedCompress runs the estimator before compressing value with codec: if the predicted
+// ratio is below MinRatio, value is stored raw behind a FrameRaw header byte; otherwise it's
+// compressed and stored behind a header byte identifying the codec.
+func FramedCompress(estimator *CompressibilityEstimator, codec Codec, value []byte) ([]byte, bool, error) {
+	if !estimator.ShouldCompress(value) {
+		out := make([]byte, 0, len(value)+1)
+		out = append(out, FrameRaw)
+		out = append(out, value...)
+		return out, false, nil
+	}
+
+	frame, err := frameByteForCodec(codec)
+	if err != nil {
+		return nil, false, err
+	}
+
+	compressed, err := codec.Compress(nil, value)
+	if err != nil {
+		return nil, false, fmt.Errorf("compressibility estimator: compression failed: %w", err)
+	}
+
+	out := make([]byte, 0, len(compressed)+1)
+	out = append(out, frame)
+	out = append(out, compressed...)
+	return out, true, nil
+}
+
+// FramedDecompress reads the framing header byte written by FramedCompress and routes to the
+// matching codec, or returns the bytes unmodified when the value was stored raw.
+func FramedDecompress(framed []byte) ([]byte, error) {
+	if len(framed) == 0 {
+		return framed, nil
+	}
+	header, payload := framed[0], framed[1:]
+	switch header {
+	case FrameRaw:
+		return payload, nil
+	case FrameZstd:
+		return (&zstdCodec{}).Decompress(nil, payload)
+	case FrameGzip:
+		return (&gzipCodec{}).Decompress(nil, payload)
+	default:
+		return nil, fmt.Errorf("compressibility estimator: unknown frame header %#x", header)
+	}
+}
+
+func frameByteForCodec(codec Codec) (byte, error) {
+	switch codec.Name() {
+	case "zstd":
+		return FrameZstd, nil
+	case "gzip":
+		return FrameGzip, nil
+	default:
+		return 0, fmt.Errorf("compressibility estimator: no frame header registered for codec %q", codec.Name())
+	}
+}
+
+// BenchmarkAdaptive runs mixed datasets (some compressible, some not) through the estimator
+// and reports how much CPU time and how many bytes were saved versus unconditionally
+// compressing every value.
+func (cb *CompressionBenchmark) BenchmarkAdaptive(datasets map[string][]string) error {
+	fmt.Println("🧠 Adaptive Compression Benchmark")
+	fmt.Println("   Compares unconditional compression against estimator-gated compression")
+	fmt.Println(strings.Repeat("-", 80))
+	fmt.Printf("%-12s %-10s %-10s %-12s %-12s\n", "Dataset", "Stored Raw", "Compressed", "Bytes Saved", "Unconditional")
+	fmt.Println(strings.Repeat("-", 80))
+
+	estimator := NewCompressibilityEstimator()
+	codec := (&zstdCodec{}).WithLevel(3)
+
+	for datasetName, data := range datasets {
+		var rawCount, compressedCount int
+		var adaptiveBytes, unconditionalBytes int64
+
+		for _, entry := range data {
+			value := []byte(entry)
+
+			framed, wasCompressed, err := FramedCompress(estimator, codec, value)
+			if err != nil {
+				return fmt.Errorf("adaptive benchmark failed for %s: %w", datasetName, err)
+			}
+			if wasCompressed {
+				compressedCount++
+			} else {
+				rawCount++
+			}
+			adaptiveBytes += int64(len(framed))
+
+			unconditional, err := codec.Compress(nil, value)
+			if err != nil {
+				return fmt.Errorf("adaptive benchmark unconditional compression failed for %s: %w", datasetName, err)
+			}
+			unconditionalBytes += int64(len(unconditional))
+		}
+
+		bytesSaved := unconditionalBytes - adaptiveBytes
+
+		fmt.Printf("%-12s %10d %10d %12d %12d\n",
+			datasetName, rawCount, compressedCount, bytesSaved, unconditionalBytes)
+	}
+	fmt.Println()
+	return nil
+}
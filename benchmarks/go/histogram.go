@@ -0,0 +1,110 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"math"
+	"sync"
+)
+
+// Histogram is a fixed-bucket HDR-style latency histogram. It tracks values from 1µs to 60s
+// at 3 significant decimal digits of precision, which is enough resolution for percentile
+// reporting without the cost of a full value-indexed recording.
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []int64
+	count   int64
+	max     int64
+}
+
+const (
+	histogramMinValueUs   = 1
+	histogramMaxValueUs   = 60 * 1000 * 1000 // 60s in microseconds
+	histogramSigDigits    = 3
+	histogramBucketFactor = 1.0 + 1.0/float64(1000) // ~3 significant digits per decade
+)
+
+// NewHistogram returns an empty Histogram covering 1µs to 60s.
+func NewHistogram() *Histogram {
+	numBuckets := int(math.Ceil(math.Log(float64(histogramMaxValueUs)/histogramMinValueUs)/math.Log(histogramBucketFactor))) + 1
+	return &Histogram{buckets: make([]int64, numBuckets)}
+}
+
+func bucketIndex(valueUs int64) int {
+	if valueUs < histogramMinValueUs {
+		valueUs = histogramMinValueUs
+	}
+	if valueUs > histogramMaxValueUs {
+		valueUs = histogramMaxValueUs
+	}
+	return int(math.Log(float64(valueUs)/histogramMinValueUs) / math.Log(histogramBucketFactor))
+}
+
+func bucketValue(idx int) int64 {
+	return int64(histogramMinValueUs * math.Pow(histogramBucketFactor, float64(idx)))
+}
+
+// RecordMicros records a single observation, given in microseconds.
+func (h *Histogram) RecordMicros(valueUs int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	idx := bucketIndex(valueUs)
+	h.buckets[idx]++
+	h.count++
+	if valueUs > h.max {
+		h.max = valueUs
+	}
+}
+
+// Percentile returns the value, in microseconds, at or below which p percent (0-100) of
+// recorded observations fall.
+func (h *Histogram) Percentile(p float64) int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.count == 0 {
+		return 0
+	}
+	target := int64(math.Ceil(p / 100 * float64(h.count)))
+	var cumulative int64
+	for idx, c := range h.buckets {
+		cumulative += c
+		if cumulative >= target {
+			return bucketValue(idx)
+		}
+	}
+	return h.max
+}
+
+// Max returns the largest recorded value, in microseconds.
+func (h *Histogram) Max() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.max
+}
+
+// Count returns the number of recorded observations.
+func (h *Histogram) Count() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+// LatencyPercentiles is a snapshot of P50/P95/P99/P999/Max, in microseconds.
+type LatencyPercentiles struct {
+	P50  int64
+	P95  int64
+	P99  int64
+	P999 int64
+	Max  int64
+}
+
+// Snapshot returns the current percentile set for the histogram.
+func (h *Histogram) Snapshot() LatencyPercentiles {
+	return LatencyPercentiles{
+		P50:  h.Percentile(50),
+		P95:  h.Percentile(95),
+		P99:  h.Percentile(99),
+		P999: h.Percentile(99.9),
+		Max:  h.Max(),
+	}
+}
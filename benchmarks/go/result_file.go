@@ -0,0 +1,92 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ResultRow is a single serialized benchmark observation, written as one JSON line per row
+// when -resultFile is set. This lets two runs (e.g. before/after a compression change, or
+// across branches) be diffed by cmd/benchresult.
+type ResultRow struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Codec      string    `json:"codec"`
+	Level      int       `json:"level"`
+	Dataset    string    `json:"dataset"`
+	Entries    int       `json:"entries"`
+	OriginalSz int64     `json:"originalSize"`
+	Ratio      float64   `json:"ratio"`
+	TPS        float64   `json:"tps"`
+	SetOps     int64     `json:"setOps"`
+	GetOps     int64     `json:"getOps"`
+	SetP50Us   int64     `json:"setP50Us"`
+	SetP95Us   int64     `json:"setP95Us"`
+	SetP99Us   int64     `json:"setP99Us"`
+	SetP999Us  int64     `json:"setP999Us"`
+	SetMaxUs   int64     `json:"setMaxUs"`
+	GetP50Us   int64     `json:"getP50Us"`
+	GetP95Us   int64     `json:"getP95Us"`
+	GetP99Us   int64     `json:"getP99Us"`
+	GetP999Us  int64     `json:"getP999Us"`
+	GetMaxUs   int64     `json:"getMaxUs"`
+	GitSHA     string    `json:"gitSha"`
+	Host       string    `json:"host"`
+	GoVersion  string    `json:"goVersion"`
+}
+
+// ResultWriter appends ResultRow entries as JSON lines to a file.
+type ResultWriter struct {
+	file *os.File
+}
+
+// NewResultWriter opens (creating if necessary) path for appending result rows.
+func NewResultWriter(path string) (*ResultWriter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open result file %s: %w", path, err)
+	}
+	return &ResultWriter{file: f}, nil
+}
+
+// Write appends row to the result file as a single line of JSON.
+func (w *ResultWriter) Write(row ResultRow) error {
+	row.Timestamp = row.Timestamp.UTC()
+	data, err := json.Marshal(row)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result row: %w", err)
+	}
+	if _, err := w.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write result row: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (w *ResultWriter) Close() error {
+	return w.file.Close()
+}
+
+// currentGitSHA returns the short SHA of HEAD, or "unknown" if it cannot be determined.
+func currentGitSHA() string {
+	out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output()
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// hostInfo returns a short description of the machine running the benchmark.
+func hostInfo() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s/%s/%s (cpus=%d)", hostname, runtime.GOOS, runtime.GOARCH, runtime.NumCPU())
+}
@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errZstdCGoUnavailable is returned by newCGoZstdCodec in builds without cgo.
+var errZstdCGoUnavailable = errors.New("zstd-cgo: binary was built without cgo")
+
+// zstdImplementationLevels are the levels compared between the pure-Go and cgo zstd
+// implementations, spanning the client's fast/default/high/max compression tiers.
+var zstdImplementationLevels = []int{1, 3, 9, 19}
+
+// BenchmarkZstdImplementations compares the pure-Go (klauspost/compress/zstd) and cgo
+// (DataDog/zstd) implementations at a range of compression levels, so users picking
+// config.ZstdImplementation have real throughput numbers instead of the ecosystem's general
+// guidance to go on.
+func (cb *CompressionBenchmark) BenchmarkZstdImplementations(datasets map[string][]string) error {
+	fmt.Println("🔀 Zstd Implementation Benchmark (pure-Go vs cgo)")
+	fmt.Println(strings.Repeat("-", 80))
+
+	if !cgoZstdAvailable {
+		fmt.Println("   Built without cgo: only the pure-Go implementation is available.")
+		fmt.Println("   Rebuild with CGO_ENABLED=1 to compare against the cgo implementation.")
+		fmt.Println()
+		return nil
+	}
+
+	fmt.Printf("%-12s %-5s %-10s %-12s %-12s %-12s\n",
+		"Dataset", "Level", "Impl", "TPS", "Ratio", "P50 Set (us)")
+	fmt.Println(strings.Repeat("-", 80))
+
+	for datasetName, data := range datasets {
+		for _, level := range zstdImplementationLevels {
+			pureGo := (&zstdCodec{}).WithLevel(level)
+			pureGoResult, err := cb.benchmarkConfiguration(pureGo, fmt.Sprintf("%s_zstd_purego_%d", datasetName, level), data)
+			if err != nil {
+				return fmt.Errorf("failed to benchmark pure-Go zstd level %d on %s: %w", level, datasetName, err)
+			}
+			fmt.Printf("%-12s %-5d %-10s %12.0f %12.3f %12d\n",
+				datasetName, level, "pure-go", pureGoResult.TPS, pureGoResult.CompressionRatio, pureGoResult.SetLatency.P50)
+
+			cgoCodec, err := newCGoZstdCodec(level)
+			if err != nil {
+				return fmt.Errorf("failed to construct cgo zstd codec at level %d: %w", level, err)
+			}
+			cgoResult, err := cb.benchmarkConfiguration(cgoCodec, fmt.Sprintf("%s_zstd_cgo_%d", datasetName, level), data)
+			if err != nil {
+				return fmt.Errorf("failed to benchmark cgo zstd level %d on %s: %w", level, datasetName, err)
+			}
+			fmt.Printf("%-12s %-5d %-10s %12.0f %12.3f %12d\n",
+				datasetName, level, "cgo", cgoResult.TPS, cgoResult.CompressionRatio, cgoResult.SetLatency.P50)
+		}
+	}
+	fmt.Println()
+	return nil
+}
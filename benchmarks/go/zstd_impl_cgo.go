@@ -0,0 +1,42 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//go:build cgo
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/DataDog/zstd"
+)
+
+// cgoZstdAvailable reports whether the cgo-backed zstd codec can be benchmarked in this build.
+const cgoZstdAvailable = true
+
+// zstdCGoCodec compresses using the cgo-backed DataDog/zstd binding, for comparison against the
+// pure-Go zstdCodec.
+type zstdCGoCodec struct {
+	level int
+}
+
+func (c *zstdCGoCodec) Name() string { return "zstd-cgo" }
+
+func (c *zstdCGoCodec) Compress(dst, src []byte) ([]byte, error) {
+	out, err := zstd.CompressLevel(nil, src, c.level)
+	if err != nil {
+		return nil, fmt.Errorf("zstd-cgo: compress failed: %w", err)
+	}
+	return append(dst, out...), nil
+}
+
+func (c *zstdCGoCodec) Decompress(dst, src []byte) ([]byte, error) {
+	out, err := zstd.Decompress(nil, src)
+	if err != nil {
+		return nil, fmt.Errorf("zstd-cgo: decompress failed: %w", err)
+	}
+	return append(dst, out...), nil
+}
+
+func newCGoZstdCodec(level int) (Codec, error) {
+	return &zstdCGoCodec{level: level}, nil
+}
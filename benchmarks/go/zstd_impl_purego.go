@@ -0,0 +1,13 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//go:build !cgo
+
+package main
+
+// cgoZstdAvailable reports whether the cgo-backed zstd codec can be benchmarked in this build.
+const cgoZstdAvailable = false
+
+// newCGoZstdCodec is unavailable in a non-cgo build.
+func newCGoZstdCodec(level int) (Codec, error) {
+	return nil, errZstdCGoUnavailable
+}
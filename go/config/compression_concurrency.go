@@ -0,0 +1,33 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "fmt"
+
+// defaultCompressionConcurrency is used when WithCompressionConcurrency is never called.
+const defaultCompressionConcurrency = 4
+
+// WithCompressionConcurrency bounds how many encoder/decoder instances the compression path
+// (Go-side pooling and, where compression happens in the Rust core, the FFI layer's own pool)
+// retains per (backend, level) tuple. Must be >= 1. Defaults to 4.
+func (config *CompressionConfig) WithCompressionConcurrency(concurrency int) *CompressionConfig {
+	config.compressionConcurrency = &concurrency
+	return config
+}
+
+// GetCompressionConcurrency returns the configured per-(backend,level) pool size, or the
+// default of 4 if WithCompressionConcurrency was never called.
+func (config *CompressionConfig) GetCompressionConcurrency() int {
+	if config.compressionConcurrency == nil {
+		return defaultCompressionConcurrency
+	}
+	return *config.compressionConcurrency
+}
+
+// validateCompressionConcurrency rejects a configured concurrency below 1.
+func (config *CompressionConfig) validateCompressionConcurrency() error {
+	if config.compressionConcurrency != nil && *config.compressionConcurrency < 1 {
+		return fmt.Errorf("compressionConcurrency must be at least 1, got %d", *config.compressionConcurrency)
+	}
+	return nil
+}
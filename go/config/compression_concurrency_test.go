@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfig_GetCompressionConcurrency_DefaultsToFour(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, defaultCompressionConcurrency, config.GetCompressionConcurrency())
+}
+
+func TestCompressionConfig_WithCompressionConcurrency(t *testing.T) {
+	config := NewCompressionConfig().WithCompressionConcurrency(16)
+	assert.Equal(t, 16, config.GetCompressionConcurrency())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithCompressionConcurrency_RejectsBelowOne(t *testing.T) {
+	config := NewCompressionConfig().WithCompressionConcurrency(0)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionConcurrency must be at least 1")
+}
+
+func TestCompressionConfig_ToProtobuf_IncludesCompressionConcurrency(t *testing.T) {
+	config := NewCompressionConfig().WithCompressionConcurrency(8)
+	proto := config.toProtobuf()
+
+	assert.EqualValues(t, 8, *proto.CompressionConcurrency)
+}
@@ -5,10 +5,27 @@ package config
 import (
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/valkey-io/valkey-glide/go/v2/internal/compression"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
 )
 
+// maxZstdDictionarySize is the largest zstd dictionary this client will accept under any
+// configuration, matching the zstd format guidance against unbounded memory use.
+const maxZstdDictionarySize = 16 * 1024 * 1024 // 16MB
+
+// RecommendedSmallValueDictionarySize is the dictionary size the zstd format guidance
+// recommends for small, structurally similar values (session blobs, JSON documents): large
+// enough to capture common structure, small enough to stay cheap to ship to every node. Pass
+// this to WithMaxZstdDictionarySize to enforce it.
+const RecommendedSmallValueDictionarySize = 112 * 1024 // 112KiB
+
+// ErrDictionaryMismatch is returned when a decoded value's embedded zstd dictID does not match
+// the dictionary configured on the client, e.g. because the fleet is mid-migration to a new
+// dictionary.
+var ErrDictionaryMismatch = errors.New("compression: stored value's dictionary does not match the configured zstd dictionary")
+
 // CompressionBackend represents the compression backend to use for automatic compression.
 type CompressionBackend int
 
@@ -26,13 +43,75 @@ const (
 	// ratio. It supports compression levels from 1 to 12, where higher levels provide better
 	// compression but slower speed.
 	Lz4
+
+	// Gzip - Use gzip compression backend.
+	//
+	// Gzip is a widely supported compression format useful for interop with tooling that
+	// already produces or consumes gzip-wrapped data. It supports compression levels from
+	// 0 to 9 (0 is HuffmanOnly, no LZ77 matching), or -1 to use the backend's default. Higher
+	// levels provide better compression but slower speed. Defaults to 6.
+	Gzip
+
+	// Snappy - Use snappy compression backend.
+	//
+	// Snappy prioritizes speed over compression ratio and is a good fit for very fast,
+	// lightly-compressible payloads. Snappy has no compression level.
+	Snappy
+
+	// Deflate - Use raw deflate compression backend.
+	//
+	// Deflate is the algorithm underlying gzip and zlib without their container framing. It
+	// supports compression levels from 0 to 9 (0 is HuffmanOnly), or -1 to use the backend's
+	// default. Higher levels provide better compression but slower speed. Defaults to 6.
+	Deflate
+
+	// Zlib - Use zlib compression backend.
+	//
+	// Zlib wraps deflate with a small header and checksum, matching tooling that expects
+	// zlib-framed data. It supports compression levels from 0 to 9 (0 is HuffmanOnly), or -1 to
+	// use the backend's default. Higher levels provide better compression but slower speed.
+	// Defaults to 6.
+	Zlib
+
+	// Brotli - Use brotli compression backend.
+	//
+	// Brotli typically achieves better compression ratios than gzip/deflate at the cost of
+	// slower compression speed. It supports compression levels (referred to as "quality") from
+	// 0 to 11, where higher levels provide better compression but slower speed. Defaults to 4.
+	Brotli
+
+	// None - Disable compression while keeping the compression configuration plumbing in place.
+	//
+	// Values are passed through unchanged. Useful for toggling compression off per-environment
+	// without removing the rest of a CompressionConfig (key patterns, metrics sink, etc.). None
+	// has no compression level.
+	None
 )
 
+// GetSupportedBackends returns all compression backends supported by this client.
+func GetSupportedBackends() []CompressionBackend {
+	return []CompressionBackend{Zstd, Lz4, Gzip, Snappy, Deflate, Zlib, Brotli, None}
+}
+
 func mapCompressionBackend(backend CompressionBackend) protobuf.CompressionBackend {
-	if backend == Lz4 {
+	switch backend {
+	case Lz4:
 		return protobuf.CompressionBackend_LZ4
+	case Gzip:
+		return protobuf.CompressionBackend_GZIP
+	case Snappy:
+		return protobuf.CompressionBackend_SNAPPY
+	case Deflate:
+		return protobuf.CompressionBackend_DEFLATE
+	case Zlib:
+		return protobuf.CompressionBackend_ZLIB
+	case Brotli:
+		return protobuf.CompressionBackend_BROTLI
+	case None:
+		return protobuf.CompressionBackend_NONE
+	default:
+		return protobuf.CompressionBackend_ZSTD
 	}
-	return protobuf.CompressionBackend_ZSTD
 }
 
 // CompressionConfig represents the compression configuration for automatic compression of values.
@@ -64,6 +143,11 @@ type CompressionConfig struct {
 	// Valid ranges:
 	// - ZSTD: 1-22 (higher values provide better compression but slower speed)
 	// - LZ4: 1-12 (higher values provide better compression but slower speed)
+	// - GZIP: 1-9 (higher values provide better compression but slower speed)
+	// - DEFLATE: 1-9 (higher values provide better compression but slower speed)
+	// - ZLIB: 1-9 (higher values provide better compression but slower speed)
+	// - BROTLI: 0-11 (higher values provide better compression but slower speed)
+	// - SNAPPY: not supported; Snappy has no compression level
 	//
 	// Defaults to nil (uses backend default).
 	compressionLevel *int
@@ -78,16 +162,68 @@ type CompressionConfig struct {
 	// If not set, no maximum limit is applied.
 	// Defaults to nil (no limit).
 	maxCompressionSize *int
+
+	// A pre-trained zstd dictionary used to improve compression of small, structurally similar
+	// values. Only valid when backend is Zstd. Defaults to nil (no dictionary).
+	zstdDictionary []byte
+
+	// The maximum accepted size in bytes for zstdDictionary. Defaults to 0, meaning
+	// maxZstdDictionarySize (16MB); callers targeting small, structurally similar values should
+	// lower this toward RecommendedSmallValueDictionarySize (112KiB). Cannot exceed
+	// maxZstdDictionarySize.
+	maxZstdDictionarySizeOverride int
+
+	// The telemetry sink for compression/decompression operations. Defaults to nil (no telemetry).
+	metrics CompressionMetrics
+
+	// KEYS-style glob patterns restricting compression to matching keys. Defaults to nil (all
+	// keys eligible).
+	includeKeyPatterns []string
+
+	// KEYS-style glob patterns opting matching keys out of compression. Takes precedence over
+	// includeKeyPatterns. Defaults to nil (no keys excluded).
+	excludeKeyPatterns []string
+
+	// The subset of commands eligible for compression. Defaults to nil (all commands eligible).
+	compressedCommands []string
+
+	// The frame header mode used to write and interpret compressed values. Defaults to
+	// FramingAuto.
+	framing FramingMode
+
+	// Whether to always compress, never compress, or decide per-value. Defaults to
+	// CompressionModeAlways.
+	mode CompressionMode
+
+	// The compressed/original size ratio below which CompressionModeAuto emits the compressed
+	// payload. Defaults to 0.95.
+	autoCompressionRatioThreshold float64
+
+	// The number of leading bytes of a candidate value CompressionModeAuto samples. Defaults to
+	// 4KiB.
+	autoSampleSize int
+
+	// Which underlying zstd library performs Zstd compression/decompression. Defaults to
+	// ZstdImplAuto.
+	zstdImplementation ZstdImplementation
+
+	// The number of encoder/decoder instances retained per (backend, level) pool key. Defaults
+	// to nil (uses defaultCompressionConcurrency).
+	compressionConcurrency *int
 }
 
 // NewCompressionConfig returns a new CompressionConfig with default settings.
 func NewCompressionConfig() *CompressionConfig {
 	return &CompressionConfig{
-		enabled:            false,
-		backend:            Zstd,
-		compressionLevel:   nil,
-		minCompressionSize: 64,
-		maxCompressionSize: nil,
+		enabled:                       false,
+		backend:                       Zstd,
+		compressionLevel:              nil,
+		minCompressionSize:            64,
+		maxCompressionSize:            nil,
+		framing:                       FramingAuto,
+		mode:                          CompressionModeAlways,
+		autoCompressionRatioThreshold: defaultAutoCompressionRatioThreshold,
+		autoSampleSize:                defaultAutoSampleSize,
 	}
 }
 
@@ -109,6 +245,11 @@ func (config *CompressionConfig) WithBackend(backend CompressionBackend) *Compre
 // Valid ranges:
 // - ZSTD: 1-22 (higher values provide better compression but slower speed)
 // - LZ4: 1-12 (higher values provide better compression but slower speed)
+// - GZIP: 1-9 (higher values provide better compression but slower speed)
+// - DEFLATE: 1-9 (higher values provide better compression but slower speed)
+// - ZLIB: 1-9 (higher values provide better compression but slower speed)
+// - BROTLI: 0-11 (higher values provide better compression but slower speed)
+// - SNAPPY: not supported; Snappy has no compression level
 func (config *CompressionConfig) WithCompressionLevel(level int) *CompressionConfig {
 	config.compressionLevel = &level
 	return config
@@ -129,6 +270,40 @@ func (config *CompressionConfig) WithMaxCompressionSize(size int) *CompressionCo
 	return config
 }
 
+// WithZstdDictionary sets a pre-trained zstd dictionary to use for compression and
+// decompression. Dictionaries are only valid when the backend is Zstd, and typically give the
+// largest win on small, structurally similar values (JSON blobs, session objects) where a cold
+// zstd model has no history to draw on. Use TrainZstdDictionary to build one from samples.
+func (config *CompressionConfig) WithZstdDictionary(dict []byte) *CompressionConfig {
+	config.zstdDictionary = dict
+	return config
+}
+
+// WithZstdDictionaryPath reads the dictionary at path and sets it via WithZstdDictionary.
+func (config *CompressionConfig) WithZstdDictionaryPath(path string) (*CompressionConfig, error) {
+	dict, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zstd dictionary from %s: %w", path, err)
+	}
+	return config.WithZstdDictionary(dict), nil
+}
+
+// WithMaxZstdDictionarySize caps the size zstdDictionary is allowed to be, overriding the
+// client's default of 16MB. Cannot exceed 16MB. Workloads of small, structurally similar values
+// should set this toward 112KiB, matching the zstd format guidance for dictionary sizing.
+func (config *CompressionConfig) WithMaxZstdDictionarySize(max int) *CompressionConfig {
+	config.maxZstdDictionarySizeOverride = max
+	return config
+}
+
+// GetMaxZstdDictionarySize returns the effective maximum accepted zstdDictionary size in bytes.
+func (config *CompressionConfig) GetMaxZstdDictionarySize() int {
+	if config.maxZstdDictionarySizeOverride > 0 {
+		return config.maxZstdDictionarySizeOverride
+	}
+	return maxZstdDictionarySize
+}
+
 // Validate validates the compression configuration parameters.
 func (config *CompressionConfig) Validate() error {
 	if config.minCompressionSize < 0 {
@@ -155,11 +330,67 @@ func (config *CompressionConfig) Validate() error {
 			if *config.compressionLevel < 1 || *config.compressionLevel > 12 {
 				return errors.New("compressionLevel for LZ4 backend must be between 1 and 12")
 			}
+		case Gzip:
+			if *config.compressionLevel != -1 && (*config.compressionLevel < 0 || *config.compressionLevel > 9) {
+				return errors.New("compressionLevel for GZIP backend must be -1 (default) or between 0 and 9")
+			}
+		case Deflate:
+			if *config.compressionLevel != -1 && (*config.compressionLevel < 0 || *config.compressionLevel > 9) {
+				return errors.New("compressionLevel for DEFLATE backend must be -1 (default) or between 0 and 9")
+			}
+		case Zlib:
+			if *config.compressionLevel != -1 && (*config.compressionLevel < 0 || *config.compressionLevel > 9) {
+				return errors.New("compressionLevel for ZLIB backend must be -1 (default) or between 0 and 9")
+			}
+		case Brotli:
+			if *config.compressionLevel < 0 || *config.compressionLevel > 11 {
+				return errors.New("compressionLevel for BROTLI backend must be between 0 and 11")
+			}
+		case Snappy:
+			return errors.New("compressionLevel is not supported for SNAPPY backend")
+		case None:
+			return errors.New("compressionLevel is not supported for NONE backend")
 		default:
 			return fmt.Errorf("unsupported compression backend: %v", config.backend)
 		}
 	}
 
+	if config.maxZstdDictionarySizeOverride < 0 {
+		return errors.New("maxZstdDictionarySize must be non-negative")
+	}
+	if config.maxZstdDictionarySizeOverride > maxZstdDictionarySize {
+		return fmt.Errorf("maxZstdDictionarySize cannot exceed %d bytes, got %d", maxZstdDictionarySize, config.maxZstdDictionarySizeOverride)
+	}
+
+	if len(config.zstdDictionary) > 0 {
+		if config.backend != Zstd {
+			return errors.New("zstdDictionary is only valid when backend is Zstd")
+		}
+		if effectiveMax := config.GetMaxZstdDictionarySize(); len(config.zstdDictionary) > effectiveMax {
+			return fmt.Errorf("zstdDictionary must be under %d bytes, got %d", effectiveMax, len(config.zstdDictionary))
+		}
+	}
+
+	if err := config.validatePolicy(); err != nil {
+		return err
+	}
+
+	if err := config.validateMode(); err != nil {
+		return err
+	}
+
+	if err := config.validateZstdImplementation(); err != nil {
+		return err
+	}
+
+	if err := config.validateCompressionConcurrency(); err != nil {
+		return err
+	}
+
+	if err := config.validateFraming(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -171,7 +402,9 @@ func (config *CompressionConfig) toProtobuf() *protobuf.CompressionConfig {
 		MinCompressionSize: uint32(config.minCompressionSize),
 	}
 
-	if config.compressionLevel != nil {
+	// -1 means "use the backend's own default" and is left unset on the wire rather than encoded,
+	// since the proto field is unsigned and has no representation for a negative sentinel.
+	if config.compressionLevel != nil && *config.compressionLevel != -1 {
 		level := uint32(*config.compressionLevel)
 		protoConfig.CompressionLevel = &level
 	}
@@ -181,9 +414,67 @@ func (config *CompressionConfig) toProtobuf() *protobuf.CompressionConfig {
 		protoConfig.MaxCompressionSize = &maxSize
 	}
 
+	if len(config.zstdDictionary) > 0 {
+		protoConfig.ZstdDictionary = config.zstdDictionary
+		dictHash := compression.DictID(config.zstdDictionary)
+		protoConfig.ZstdDictionaryHash = &dictHash
+	}
+
+	protoConfig.CompressionMode = mapCompressionMode(config.mode)
+	if config.mode == CompressionModeAuto {
+		ratio := float32(config.autoCompressionRatioThreshold)
+		protoConfig.AutoCompressionRatioThreshold = &ratio
+		sampleSize := uint32(config.autoSampleSize)
+		protoConfig.AutoSampleSize = &sampleSize
+	}
+
+	protoConfig.ZstdImplementation = mapZstdImplementation(config.zstdImplementation)
+
+	concurrency := uint32(config.GetCompressionConcurrency())
+	protoConfig.CompressionConcurrency = &concurrency
+
+	protoConfig.IncludeKeyPatterns = config.includeKeyPatterns
+	protoConfig.ExcludeKeyPatterns = config.excludeKeyPatterns
+	protoConfig.CompressedCommands = config.compressedCommands
+
+	protoConfig.Framing = mapFramingMode(config.framing)
+
 	return protoConfig
 }
 
+func mapZstdImplementation(impl ZstdImplementation) protobuf.ZstdImplementation {
+	switch impl {
+	case ZstdImplPureGo:
+		return protobuf.ZstdImplementation_ZSTD_IMPL_PURE_GO
+	case ZstdImplCGo:
+		return protobuf.ZstdImplementation_ZSTD_IMPL_CGO
+	default:
+		return protobuf.ZstdImplementation_ZSTD_IMPL_AUTO
+	}
+}
+
+func mapFramingMode(mode FramingMode) protobuf.FramingMode {
+	switch mode {
+	case FramingLegacy:
+		return protobuf.FramingMode_FRAMING_LEGACY
+	case FramingStrict:
+		return protobuf.FramingMode_FRAMING_STRICT
+	default:
+		return protobuf.FramingMode_FRAMING_AUTO
+	}
+}
+
+func mapCompressionMode(mode CompressionMode) protobuf.CompressionMode {
+	switch mode {
+	case CompressionModeOff:
+		return protobuf.CompressionMode_COMPRESSION_OFF
+	case CompressionModeAuto:
+		return protobuf.CompressionMode_COMPRESSION_AUTO
+	default:
+		return protobuf.CompressionMode_COMPRESSION_ALWAYS
+	}
+}
+
 // IsEnabled returns whether compression is enabled.
 func (config *CompressionConfig) IsEnabled() bool {
 	return config.enabled
@@ -208,3 +499,8 @@ func (config *CompressionConfig) GetMinCompressionSize() int {
 func (config *CompressionConfig) GetMaxCompressionSize() *int {
 	return config.maxCompressionSize
 }
+
+// GetZstdDictionary returns the configured zstd dictionary, or nil if none is set.
+func (config *CompressionConfig) GetZstdDictionary() []byte {
+	return config.zstdDictionary
+}
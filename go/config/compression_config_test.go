@@ -83,6 +83,78 @@ func TestCompressionConfig_InvalidLz4CompressionLevel(t *testing.T) {
 	assert.Contains(t, err.Error(), "compressionLevel for LZ4 backend must be between 1 and 12")
 }
 
+func TestCompressionConfig_ValidGzipCompressionLevel(t *testing.T) {
+	configDefault := NewCompressionConfig().WithBackend(Gzip).WithCompressionLevel(-1)
+	assert.NoError(t, configDefault.Validate())
+
+	config0 := NewCompressionConfig().WithBackend(Gzip).WithCompressionLevel(0)
+	assert.NoError(t, config0.Validate())
+
+	config9 := NewCompressionConfig().WithBackend(Gzip).WithCompressionLevel(9)
+	assert.NoError(t, config9.Validate())
+}
+
+func TestCompressionConfig_InvalidGzipCompressionLevel(t *testing.T) {
+	configNeg2 := NewCompressionConfig().WithBackend(Gzip).WithCompressionLevel(-2)
+	err := configNeg2.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel for GZIP backend must be -1 (default) or between 0 and 9")
+
+	config10 := NewCompressionConfig().WithBackend(Gzip).WithCompressionLevel(10)
+	err = config10.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel for GZIP backend must be -1 (default) or between 0 and 9")
+}
+
+func TestCompressionConfig_ValidDeflateAndZlibCompressionLevel(t *testing.T) {
+	deflate := NewCompressionConfig().WithBackend(Deflate).WithCompressionLevel(9)
+	assert.NoError(t, deflate.Validate())
+
+	zlib := NewCompressionConfig().WithBackend(Zlib).WithCompressionLevel(1)
+	assert.NoError(t, zlib.Validate())
+}
+
+func TestCompressionConfig_ValidBrotliCompressionLevel(t *testing.T) {
+	config0 := NewCompressionConfig().WithBackend(Brotli).WithCompressionLevel(0)
+	assert.NoError(t, config0.Validate())
+
+	config11 := NewCompressionConfig().WithBackend(Brotli).WithCompressionLevel(11)
+	assert.NoError(t, config11.Validate())
+}
+
+func TestCompressionConfig_InvalidBrotliCompressionLevel(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(Brotli).WithCompressionLevel(12)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel for BROTLI backend must be between 0 and 11")
+}
+
+func TestCompressionConfig_SnappyRejectsCompressionLevel(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(Snappy).WithCompressionLevel(3)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel is not supported for SNAPPY backend")
+}
+
+func TestGetSupportedBackends(t *testing.T) {
+	backends := GetSupportedBackends()
+	assert.Contains(t, backends, Zstd)
+	assert.Contains(t, backends, Lz4)
+	assert.Contains(t, backends, Gzip)
+	assert.Contains(t, backends, Snappy)
+	assert.Contains(t, backends, Deflate)
+	assert.Contains(t, backends, Zlib)
+	assert.Contains(t, backends, Brotli)
+	assert.Contains(t, backends, None)
+}
+
+func TestCompressionConfig_NoneRejectsCompressionLevel(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(None).WithCompressionLevel(3)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel is not supported for NONE backend")
+}
+
 func TestCompressionConfig_ValidMinCompressionSize(t *testing.T) {
 	config0 := NewCompressionConfig().WithMinCompressionSize(0)
 	assert.NoError(t, config0.Validate())
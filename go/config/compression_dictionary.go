@@ -0,0 +1,111 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	trainerSegmentSize = 128
+)
+
+// TrainZstdDictionary builds a zstd dictionary from real workload samples, selecting segments
+// of the samples that maximize coverage of distinct substrings and concatenating them. The
+// result is raw content rather than a spec-compliant dictionary with entropy tables: the pooled
+// zstd codec loads it with WithEncoderDictRaw/WithDecoderDictRaw, keyed by compression.DictID, so
+// it can be passed directly to WithZstdDictionary.
+func TrainZstdDictionary(samples [][]byte, targetSize int) ([]byte, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("TrainZstdDictionary: at least one sample is required")
+	}
+	if targetSize <= 0 {
+		return nil, fmt.Errorf("TrainZstdDictionary: targetSize must be positive")
+	}
+
+	segments := selectCoverageSegments(samples, targetSize)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("TrainZstdDictionary: samples were too small to extract any segments")
+	}
+
+	content := make([]byte, 0, targetSize)
+	for _, seg := range segments {
+		if len(content)+len(seg) > targetSize {
+			break
+		}
+		content = append(content, seg...)
+	}
+
+	return content, nil
+}
+
+func selectCoverageSegments(samples [][]byte, targetSize int) [][]byte {
+	type candidate struct {
+		bytes    []byte
+		trigrams map[string]struct{}
+	}
+
+	var candidates []candidate
+	for _, s := range samples {
+		if len(s) < trainerSegmentSize {
+			continue
+		}
+		for start := 0; start+trainerSegmentSize <= len(s); start += trainerSegmentSize {
+			window := s[start : start+trainerSegmentSize]
+			candidates = append(candidates, candidate{bytes: window, trigrams: trigramSet(window)})
+		}
+	}
+	if len(candidates) == 0 {
+		// Samples are all smaller than a segment; fall back to using them whole.
+		for _, s := range samples {
+			candidates = append(candidates, candidate{bytes: s, trigrams: trigramSet(s)})
+		}
+	}
+
+	covered := make(map[string]struct{})
+	var chosen [][]byte
+	total := 0
+
+	for total < targetSize && len(candidates) > 0 {
+		bestIdx, bestGain := -1, -1
+		for i, c := range candidates {
+			gain := 0
+			for g := range c.trigrams {
+				if _, ok := covered[g]; !ok {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				bestGain, bestIdx = gain, i
+			}
+		}
+		if bestIdx == -1 || bestGain <= 0 {
+			break
+		}
+		chosen = append(chosen, candidates[bestIdx].bytes)
+		total += len(candidates[bestIdx].bytes)
+		for g := range candidates[bestIdx].trigrams {
+			covered[g] = struct{}{}
+		}
+		candidates = append(candidates[:bestIdx], candidates[bestIdx+1:]...)
+	}
+
+	// Pad with the largest remaining segments if coverage ran dry before reaching targetSize.
+	sort.Slice(candidates, func(i, j int) bool { return len(candidates[i].bytes) > len(candidates[j].bytes) })
+	for total < targetSize && len(candidates) > 0 {
+		chosen = append(chosen, candidates[0].bytes)
+		total += len(candidates[0].bytes)
+		candidates = candidates[1:]
+	}
+
+	return chosen
+}
+
+func trigramSet(data []byte) map[string]struct{} {
+	set := make(map[string]struct{})
+	for i := 0; i+3 <= len(data); i++ {
+		set[string(data[i:i+3])] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,57 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrainZstdDictionary_ProducesNonEmptyDictionary(t *testing.T) {
+	samples := make([][]byte, 0, 20)
+	for i := 0; i < 20; i++ {
+		samples = append(samples, []byte(strings.Repeat("session_token_payload_", 10)))
+	}
+
+	dict, err := TrainZstdDictionary(samples, 256)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, dict)
+	assert.True(t, len(dict) <= 256) // bounded by targetSize
+}
+
+func TestTrainZstdDictionary_RequiresSamples(t *testing.T) {
+	_, err := TrainZstdDictionary(nil, 256)
+	assert.Error(t, err)
+}
+
+func TestTrainZstdDictionary_RequiresPositiveTargetSize(t *testing.T) {
+	_, err := TrainZstdDictionary([][]byte{[]byte("data")}, 0)
+	assert.Error(t, err)
+}
+
+func TestCompressionConfig_WithZstdDictionary(t *testing.T) {
+	dict := []byte("trained dictionary bytes")
+	config := NewCompressionConfig().WithBackend(Zstd).WithZstdDictionary(dict)
+
+	assert.NoError(t, config.Validate())
+	assert.True(t, bytes.Equal(dict, config.GetZstdDictionary()))
+}
+
+func TestCompressionConfig_ZstdDictionaryRejectedForOtherBackends(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(Lz4).WithZstdDictionary([]byte("dict"))
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "zstdDictionary is only valid when backend is Zstd")
+}
+
+func TestCompressionConfig_ZstdDictionaryTooLarge(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(Zstd).WithZstdDictionary(make([]byte, maxZstdDictionarySize+1))
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be under")
+}
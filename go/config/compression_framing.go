@@ -0,0 +1,105 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// frameMagic identifies a framed compressed value on the wire, so a reader can tell a
+// compressed value apart from raw, uncompressed bytes regardless of its own write config.
+var frameMagic = [4]byte{0x00, 'V', 'G', 'Z'}
+
+// currentFrameVersion is the format version written into new frames. A reader that understands
+// a lower version number than this may still choose to reject it; this client only ever writes
+// currentFrameVersion and reads any version it recognizes.
+const currentFrameVersion = 1
+
+// frameHeaderSize is the size in bytes of the header prepended to a framed compressed value:
+// 4-byte magic, 1-byte format version, 1-byte backend id, 2 bytes reserved flags.
+const frameHeaderSize = 8
+
+// ErrUnsupportedCompressionFormat is returned when a value's frame header is recognized (the
+// magic matches) but identifies a backend this client does not support, e.g. because it was
+// written by a newer client version.
+var ErrUnsupportedCompressionFormat = errors.New("compression: stored value uses an unsupported compression format")
+
+// FramingMode controls whether compressed values carry an explicit header identifying the
+// backend and format version used to write them.
+type FramingMode int
+
+const (
+	// FramingAuto writes framed values (with a header) and reads either framed or unframed
+	// (legacy, headerless) values. This is the default: it lets a fleet migrate backends or
+	// introduce dictionaries without a coordinated flag day, while still reading data written by
+	// older clients.
+	FramingAuto FramingMode = iota
+
+	// FramingLegacy never writes a header and assumes any value it reads is either raw or
+	// compressed with the currently configured backend, matching this client's original
+	// behavior before frame headers existed.
+	FramingLegacy
+
+	// FramingStrict writes framed values and refuses to read data that looks compressed but
+	// lacks a recognized frame header, instead of guessing. Use this when correctness under a
+	// backend migration matters more than compatibility with never-framed legacy data.
+	FramingStrict
+)
+
+// WithFraming sets the framing mode used to write and interpret compressed values. Defaults to
+// FramingAuto.
+func (config *CompressionConfig) WithFraming(mode FramingMode) *CompressionConfig {
+	config.framing = mode
+	return config
+}
+
+// GetFraming returns the configured framing mode.
+func (config *CompressionConfig) GetFraming() FramingMode {
+	return config.framing
+}
+
+// validateFraming rejects a framing mode outside the FramingAuto/FramingLegacy/FramingStrict
+// range, e.g. an invalid int cast from outside the package.
+func (config *CompressionConfig) validateFraming() error {
+	switch config.framing {
+	case FramingAuto, FramingLegacy, FramingStrict:
+		return nil
+	default:
+		return fmt.Errorf("unsupported framing mode: %v", config.framing)
+	}
+}
+
+// EncodeFrame prepends a frame header identifying backend to a compressed payload.
+func EncodeFrame(backend CompressionBackend, payload []byte) []byte {
+	framed := make([]byte, 0, frameHeaderSize+len(payload))
+	framed = append(framed, frameMagic[:]...)
+	framed = append(framed, byte(currentFrameVersion), byte(backend), 0, 0)
+	framed = append(framed, payload...)
+	return framed
+}
+
+// DecodeFrame inspects data for a frame header. If one is present and recognized, it returns the
+// backend it identifies and the payload with the header stripped, with framed=true. If no frame
+// header is present, it returns the input unchanged with framed=false so the caller can fall back
+// to legacy, headerless handling. If a header is present but identifies a backend this client
+// does not support, it returns ErrUnsupportedCompressionFormat.
+func DecodeFrame(data []byte) (backend CompressionBackend, payload []byte, framed bool, err error) {
+	if len(data) < frameHeaderSize || [4]byte(data[0:4]) != frameMagic {
+		return 0, data, false, nil
+	}
+
+	backend = CompressionBackend(data[5])
+	supported := false
+	for _, b := range GetSupportedBackends() {
+		if b == backend {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return 0, nil, true, fmt.Errorf("%w: backend id %d", ErrUnsupportedCompressionFormat, data[5])
+	}
+
+	return backend, data[frameHeaderSize:], true, nil
+}
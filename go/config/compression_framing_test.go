@@ -0,0 +1,74 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+)
+
+func TestCompressionConfig_WithFraming_DefaultsToAuto(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, FramingAuto, config.GetFraming())
+}
+
+func TestCompressionConfig_WithFraming(t *testing.T) {
+	config := NewCompressionConfig().WithFraming(FramingStrict)
+	assert.Equal(t, FramingStrict, config.GetFraming())
+}
+
+func TestEncodeDecodeFrame_RoundTrip(t *testing.T) {
+	payload := []byte("compressed-bytes-go-here")
+	framed := EncodeFrame(Lz4, payload)
+
+	backend, decoded, wasFramed, err := DecodeFrame(framed)
+	assert.NoError(t, err)
+	assert.True(t, wasFramed)
+	assert.Equal(t, Lz4, backend)
+	assert.Equal(t, payload, decoded)
+}
+
+func TestDecodeFrame_UnframedDataPassesThrough(t *testing.T) {
+	raw := []byte("just some raw bytes, no header")
+
+	backend, decoded, wasFramed, err := DecodeFrame(raw)
+	assert.NoError(t, err)
+	assert.False(t, wasFramed)
+	assert.Equal(t, CompressionBackend(0), backend)
+	assert.Equal(t, raw, decoded)
+}
+
+func TestDecodeFrame_UnknownBackendIsUnsupported(t *testing.T) {
+	payload := []byte("payload")
+	framed := EncodeFrame(Zstd, payload)
+	framed[5] = 0xFF // corrupt the backend id to something unrecognized
+
+	_, _, wasFramed, err := DecodeFrame(framed)
+	assert.True(t, wasFramed)
+	assert.True(t, errors.Is(err, ErrUnsupportedCompressionFormat))
+}
+
+func TestDecodeFrame_ShortInputIsTreatedAsUnframed(t *testing.T) {
+	_, decoded, wasFramed, err := DecodeFrame([]byte{0x00, 'V'})
+	assert.NoError(t, err)
+	assert.False(t, wasFramed)
+	assert.Equal(t, []byte{0x00, 'V'}, decoded)
+}
+
+func TestCompressionConfig_Validate_RejectsUnknownFraming(t *testing.T) {
+	config := NewCompressionConfig().WithFraming(FramingMode(99))
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported framing mode")
+}
+
+func TestCompressionConfig_ToProtobuf_IncludesFraming(t *testing.T) {
+	config := NewCompressionConfig().WithFraming(FramingStrict)
+	proto := config.toProtobuf()
+
+	assert.Equal(t, protobuf.FramingMode_FRAMING_STRICT, proto.Framing)
+}
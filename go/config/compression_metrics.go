@@ -0,0 +1,189 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"expvar"
+	"sync"
+	"time"
+)
+
+// SkipReason identifies why a candidate value was left uncompressed.
+type SkipReason string
+
+const (
+	// SkipBelowMinSize means the value was smaller than MinCompressionSize.
+	SkipBelowMinSize SkipReason = "below_min"
+
+	// SkipAboveMaxSize means the value was larger than MaxCompressionSize.
+	SkipAboveMaxSize SkipReason = "above_max"
+
+	// SkipDisabled means compression was disabled on the config.
+	SkipDisabled SkipReason = "disabled"
+
+	// SkipNotCompressible means the value's policy (key pattern, command scoping) excluded it.
+	SkipNotCompressible SkipReason = "not_compressible"
+
+	// SkipAutoModeIneffective means CompressionModeAuto sampled the value, found it didn't shrink
+	// past AutoCompressionRatioThreshold, and sent it raw.
+	SkipAutoModeIneffective SkipReason = "auto_mode_ineffective"
+)
+
+// CompressionMetrics receives telemetry from the compression path so operators can evaluate
+// whether compression is net-positive on their workload without wrapping every call themselves.
+// Implementations must be safe for concurrent use, since Set/Get paths may invoke them from
+// multiple goroutines.
+//
+// This single interface (and WithMetrics/GetMetrics below) is the one telemetry hook for the
+// compression path; a separately proposed CompressionMetricsSink with WithMetricsSink and
+// RecordCompression/RecordSkip/RecordDecompression methods was folded into this one instead of
+// shipped as a second, overlapping API. Code written against those other names won't compile
+// against this package - use RecordCompressed/RecordSkipped/RecordDecompressed here instead.
+type CompressionMetrics interface {
+	// RecordCompressed is called after a value is successfully compressed on the write path.
+	RecordCompressed(backend string, origSize, compSize int, dur time.Duration)
+
+	// RecordDecompressed is called after a value is successfully decompressed on the read path.
+	RecordDecompressed(backend string, origSize, compSize int, dur time.Duration)
+
+	// RecordSkipped is called when a value is left uncompressed without attempting compression.
+	RecordSkipped(reason SkipReason, size int)
+
+	// RecordError is called when a compression or decompression attempt fails. op is "compress"
+	// or "decompress".
+	RecordError(op, backend string, err error)
+}
+
+// WithMetrics sets the CompressionMetrics implementation that receives telemetry for this
+// configuration's compression and decompression operations. Defaults to nil (no telemetry).
+func (config *CompressionConfig) WithMetrics(metrics CompressionMetrics) *CompressionConfig {
+	config.metrics = metrics
+	return config
+}
+
+// GetMetrics returns the configured CompressionMetrics implementation, or nil if none is set.
+func (config *CompressionConfig) GetMetrics() CompressionMetrics {
+	return config.metrics
+}
+
+// CompressionStats is a point-in-time snapshot of cumulative compression counters, as reported
+// by NewExpvarCompressionMetrics.Stats.
+type CompressionStats struct {
+	BytesIn                int64
+	BytesOut               int64
+	CompressedCount        int64
+	DecompressedCount      int64
+	CompressDuration       time.Duration
+	SkippedBelowMin        int64
+	SkippedAboveMax        int64
+	SkippedDisabled        int64
+	SkippedNotCompressible int64
+	Errors                 int64
+}
+
+// CompressionRatio returns BytesOut/BytesIn for compressed values, or 0 if nothing has been
+// compressed yet. A ratio below 1 means compression is shrinking values.
+func (s CompressionStats) CompressionRatio() float64 {
+	if s.BytesIn == 0 {
+		return 0
+	}
+	return float64(s.BytesOut) / float64(s.BytesIn)
+}
+
+// AvgCompressLatency returns the average duration of a RecordCompressed call, or 0 if nothing
+// has been compressed yet.
+func (s CompressionStats) AvgCompressLatency() time.Duration {
+	if s.CompressedCount == 0 {
+		return 0
+	}
+	return s.CompressDuration / time.Duration(s.CompressedCount)
+}
+
+// ExpvarCompressionMetrics is the default CompressionMetrics implementation. It accumulates
+// cumulative counters in memory and, if name is non-empty, also publishes them under expvar so
+// they show up alongside the process's other expvar-exposed metrics.
+type ExpvarCompressionMetrics struct {
+	mu    sync.Mutex
+	stats CompressionStats
+
+	published *expvar.Map
+}
+
+// NewExpvarCompressionMetrics returns a CompressionMetrics that accumulates counters in memory.
+// If name is non-empty, the counters are also published via expvar.Publish(name, ...); name must
+// be unique per process.
+func NewExpvarCompressionMetrics(name string) *ExpvarCompressionMetrics {
+	m := &ExpvarCompressionMetrics{}
+	if name != "" {
+		m.published = expvar.NewMap(name)
+	}
+	return m
+}
+
+func (m *ExpvarCompressionMetrics) RecordCompressed(backend string, origSize, compSize int, dur time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stats.BytesIn += int64(origSize)
+	m.stats.BytesOut += int64(compSize)
+	m.stats.CompressedCount++
+	m.stats.CompressDuration += dur
+	m.publishLocked()
+}
+
+func (m *ExpvarCompressionMetrics) RecordDecompressed(backend string, origSize, compSize int, dur time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stats.DecompressedCount++
+	m.publishLocked()
+}
+
+func (m *ExpvarCompressionMetrics) RecordSkipped(reason SkipReason, size int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch reason {
+	case SkipBelowMinSize:
+		m.stats.SkippedBelowMin++
+	case SkipAboveMaxSize:
+		m.stats.SkippedAboveMax++
+	case SkipDisabled:
+		m.stats.SkippedDisabled++
+	case SkipNotCompressible, SkipAutoModeIneffective:
+		m.stats.SkippedNotCompressible++
+	}
+	m.publishLocked()
+}
+
+func (m *ExpvarCompressionMetrics) RecordError(op, backend string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stats.Errors++
+	m.publishLocked()
+}
+
+// Stats returns a snapshot of the cumulative counters recorded so far.
+func (m *ExpvarCompressionMetrics) Stats() CompressionStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+// publishLocked refreshes the expvar-published view of the stats. Callers must hold m.mu.
+func (m *ExpvarCompressionMetrics) publishLocked() {
+	if m.published == nil {
+		return
+	}
+	m.published.Set("bytes_in", int64Var(m.stats.BytesIn))
+	m.published.Set("bytes_out", int64Var(m.stats.BytesOut))
+	m.published.Set("compressed_count", int64Var(m.stats.CompressedCount))
+	m.published.Set("decompressed_count", int64Var(m.stats.DecompressedCount))
+	m.published.Set("errors", int64Var(m.stats.Errors))
+}
+
+// int64Var adapts a plain int64 to expvar.Var.
+type int64Var int64
+
+func (v int64Var) String() string {
+	iv := expvar.Int{}
+	iv.Set(int64(v))
+	return iv.String()
+}
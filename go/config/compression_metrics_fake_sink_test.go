@@ -0,0 +1,64 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMetricsSink is a CompressionMetrics implementation used only to assert call counts and
+// byte totals in tests, without depending on expvar or a real metrics backend.
+type fakeMetricsSink struct {
+	compressedCalls   int
+	decompressedCalls int
+	bytesIn           int
+	bytesOut          int
+	skips             map[SkipReason]int
+	errs              int
+}
+
+func newFakeMetricsSink() *fakeMetricsSink {
+	return &fakeMetricsSink{skips: make(map[SkipReason]int)}
+}
+
+func (f *fakeMetricsSink) RecordCompressed(backend string, origSize, compSize int, dur time.Duration) {
+	f.compressedCalls++
+	f.bytesIn += origSize
+	f.bytesOut += compSize
+}
+
+func (f *fakeMetricsSink) RecordDecompressed(backend string, origSize, compSize int, dur time.Duration) {
+	f.decompressedCalls++
+}
+
+func (f *fakeMetricsSink) RecordSkipped(reason SkipReason, size int) {
+	f.skips[reason]++
+}
+
+func (f *fakeMetricsSink) RecordError(op, backend string, err error) {
+	f.errs++
+}
+
+func TestFakeMetricsSink_TracksCompressedSkippedAndErroredPaths(t *testing.T) {
+	sink := newFakeMetricsSink()
+	config := NewCompressionConfig().WithMetrics(sink)
+
+	config.GetMetrics().RecordCompressed("zstd", 1000, 100, time.Millisecond)
+	config.GetMetrics().RecordCompressed("zstd", 500, 50, time.Millisecond)
+	config.GetMetrics().RecordSkipped(SkipBelowMinSize, 10)
+	config.GetMetrics().RecordSkipped(SkipAutoModeIneffective, 20)
+	config.GetMetrics().RecordError("compress", "zstd", errors.New("boom"))
+	config.GetMetrics().RecordDecompressed("zstd", 100, 1000, time.Millisecond)
+
+	assert.Equal(t, 2, sink.compressedCalls)
+	assert.Equal(t, 1500, sink.bytesIn)
+	assert.Equal(t, 150, sink.bytesOut)
+	assert.Equal(t, 1, sink.skips[SkipBelowMinSize])
+	assert.Equal(t, 1, sink.skips[SkipAutoModeIneffective])
+	assert.Equal(t, 1, sink.errs)
+	assert.Equal(t, 1, sink.decompressedCalls)
+}
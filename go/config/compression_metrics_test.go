@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfig_WithMetrics(t *testing.T) {
+	metrics := NewExpvarCompressionMetrics("")
+	config := NewCompressionConfig().WithMetrics(metrics)
+
+	assert.Same(t, metrics, config.GetMetrics())
+}
+
+func TestExpvarCompressionMetrics_RecordCompressed(t *testing.T) {
+	metrics := NewExpvarCompressionMetrics("")
+	metrics.RecordCompressed("zstd", 1000, 200, 5*time.Millisecond)
+	metrics.RecordCompressed("zstd", 2000, 400, 5*time.Millisecond)
+
+	stats := metrics.Stats()
+	assert.EqualValues(t, 3000, stats.BytesIn)
+	assert.EqualValues(t, 600, stats.BytesOut)
+	assert.EqualValues(t, 2, stats.CompressedCount)
+	assert.InDelta(t, 0.2, stats.CompressionRatio(), 0.0001)
+	assert.Equal(t, 5*time.Millisecond, stats.AvgCompressLatency())
+}
+
+func TestExpvarCompressionMetrics_RecordSkipped(t *testing.T) {
+	metrics := NewExpvarCompressionMetrics("")
+	metrics.RecordSkipped(SkipBelowMinSize, 10)
+	metrics.RecordSkipped(SkipAboveMaxSize, 20)
+	metrics.RecordSkipped(SkipDisabled, 30)
+	metrics.RecordSkipped(SkipNotCompressible, 40)
+
+	stats := metrics.Stats()
+	assert.EqualValues(t, 1, stats.SkippedBelowMin)
+	assert.EqualValues(t, 1, stats.SkippedAboveMax)
+	assert.EqualValues(t, 1, stats.SkippedDisabled)
+	assert.EqualValues(t, 1, stats.SkippedNotCompressible)
+}
+
+func TestExpvarCompressionMetrics_RecordError(t *testing.T) {
+	metrics := NewExpvarCompressionMetrics("")
+	metrics.RecordError("compress", "zstd", errors.New("boom"))
+
+	assert.EqualValues(t, 1, metrics.Stats().Errors)
+}
+
+func TestCompressionStats_RatioAndLatencyAreZeroWhenEmpty(t *testing.T) {
+	var stats CompressionStats
+	assert.Zero(t, stats.CompressionRatio())
+	assert.Zero(t, stats.AvgCompressLatency())
+}
+
+func TestNewExpvarCompressionMetrics_PublishesUnderName(t *testing.T) {
+	metrics := NewExpvarCompressionMetrics("test_compression_metrics_publish")
+	metrics.RecordCompressed("zstd", 100, 20, time.Millisecond)
+
+	assert.NotNil(t, metrics.published.Get("bytes_in"))
+}
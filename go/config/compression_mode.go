@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "fmt"
+
+// defaultAutoCompressionRatioThreshold is the default ratio below which CompressionModeAuto will
+// emit the compressed payload instead of falling back to the raw value.
+const defaultAutoCompressionRatioThreshold = 0.95
+
+// defaultAutoSampleSize is the default number of leading bytes of a candidate value sampled to
+// decide whether CompressionModeAuto should compress it.
+const defaultAutoSampleSize = 4 * 1024
+
+// CompressionMode controls whether and how aggressively values are compressed.
+type CompressionMode int
+
+const (
+	// CompressionModeAlways compresses every eligible value (subject to MinCompressionSize /
+	// MaxCompressionSize and any key/command policy). This is the client's original behavior.
+	CompressionModeAlways CompressionMode = iota
+
+	// CompressionModeOff never compresses values, regardless of the other compression settings.
+	CompressionModeOff
+
+	// CompressionModeAuto compresses a leading sample of each candidate value and only emits the
+	// compressed payload if it shrinks below AutoCompressionRatioThreshold; otherwise the raw
+	// value is sent uncompressed. This avoids paying the compression tax on values that are
+	// already compressed (JPEGs, gzipped JSON, etc.).
+	CompressionModeAuto
+)
+
+// WithCompressionMode sets the compression mode. Defaults to CompressionModeAlways.
+func (config *CompressionConfig) WithCompressionMode(mode CompressionMode) *CompressionConfig {
+	config.mode = mode
+	return config
+}
+
+// GetCompressionMode returns the configured compression mode.
+func (config *CompressionConfig) GetCompressionMode() CompressionMode {
+	return config.mode
+}
+
+// WithAutoCompressionRatioThreshold sets the compressed/original size ratio below which
+// CompressionModeAuto emits the compressed payload. Must be in (0, 1]. Defaults to 0.95.
+func (config *CompressionConfig) WithAutoCompressionRatioThreshold(threshold float64) *CompressionConfig {
+	config.autoCompressionRatioThreshold = threshold
+	return config
+}
+
+// GetAutoCompressionRatioThreshold returns the configured auto-mode ratio threshold.
+func (config *CompressionConfig) GetAutoCompressionRatioThreshold() float64 {
+	return config.autoCompressionRatioThreshold
+}
+
+// WithAutoSampleSize sets how many leading bytes of a candidate value CompressionModeAuto
+// samples to decide whether to compress it. Defaults to 4KiB.
+func (config *CompressionConfig) WithAutoSampleSize(size int) *CompressionConfig {
+	config.autoSampleSize = size
+	return config
+}
+
+// GetAutoSampleSize returns the configured auto-mode sample size in bytes.
+func (config *CompressionConfig) GetAutoSampleSize() int {
+	return config.autoSampleSize
+}
+
+// validateMode checks the compression-mode settings and returns the first violation found, or
+// nil if they are internally consistent.
+func (config *CompressionConfig) validateMode() error {
+	if config.autoCompressionRatioThreshold <= 0 || config.autoCompressionRatioThreshold > 1 {
+		return fmt.Errorf("autoCompressionRatioThreshold must be in (0, 1], got %v", config.autoCompressionRatioThreshold)
+	}
+	if config.autoSampleSize <= 0 {
+		return fmt.Errorf("autoSampleSize must be positive, got %d", config.autoSampleSize)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfig_WithCompressionMode_DefaultsToAlways(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, CompressionModeAlways, config.GetCompressionMode())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithCompressionMode_Off(t *testing.T) {
+	config := NewCompressionConfig().WithCompressionMode(CompressionModeOff)
+	assert.Equal(t, CompressionModeOff, config.GetCompressionMode())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithCompressionMode_Auto(t *testing.T) {
+	config := NewCompressionConfig().WithCompressionMode(CompressionModeAuto)
+	assert.Equal(t, CompressionModeAuto, config.GetCompressionMode())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithAutoCompressionRatioThreshold(t *testing.T) {
+	config := NewCompressionConfig().WithAutoCompressionRatioThreshold(0.8)
+	assert.Equal(t, 0.8, config.GetAutoCompressionRatioThreshold())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_InvalidAutoCompressionRatioThreshold(t *testing.T) {
+	tooLow := NewCompressionConfig().WithAutoCompressionRatioThreshold(0)
+	err := tooLow.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "autoCompressionRatioThreshold must be in (0, 1]")
+
+	tooHigh := NewCompressionConfig().WithAutoCompressionRatioThreshold(1.5)
+	err = tooHigh.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "autoCompressionRatioThreshold must be in (0, 1]")
+}
+
+func TestCompressionConfig_WithAutoSampleSize(t *testing.T) {
+	config := NewCompressionConfig().WithAutoSampleSize(1024)
+	assert.Equal(t, 1024, config.GetAutoSampleSize())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_InvalidAutoSampleSize(t *testing.T) {
+	config := NewCompressionConfig().WithAutoSampleSize(0)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "autoSampleSize must be positive")
+}
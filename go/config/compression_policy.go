@@ -0,0 +1,266 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// incompatibleCompressionCommands lists commands that cannot meaningfully operate on a
+// compressed payload. Allowing compression on these would silently corrupt data: APPEND and
+// SETRANGE mutate byte offsets within the stored value, GETRANGE/BITCOUNT/STRLEN reason about
+// the uncompressed byte layout, and none of them round-trip through the compression codec.
+var incompatibleCompressionCommands = map[string]struct{}{
+	"APPEND":      {},
+	"SETRANGE":    {},
+	"GETRANGE":    {},
+	"BITCOUNT":    {},
+	"BITPOS":      {},
+	"STRLEN":      {},
+	"SETBIT":      {},
+	"GETBIT":      {},
+	"INCR":        {},
+	"INCRBY":      {},
+	"INCRBYFLOAT": {},
+	"DECR":        {},
+	"DECRBY":      {},
+}
+
+// WithIncludeKeyPatterns restricts compression to keys matching at least one of the given
+// KEYS-style glob patterns (`*`, `?`, `[...]`, matched with the same semantics as the Redis KEYS
+// command - unlike path/filepath.Match, `*` and `?` cross `/` freely, since Valkey keys have no
+// notion of a path separator). If unset, all keys are eligible (subject to ExcludeKeyPatterns and
+// the other compression settings).
+func (config *CompressionConfig) WithIncludeKeyPatterns(patterns []string) *CompressionConfig {
+	config.includeKeyPatterns = patterns
+	return config
+}
+
+// WithExcludeKeyPatterns opts keys matching any of the given KEYS-style glob patterns out of
+// compression, even if they also match an include pattern. Exclude takes precedence over include.
+func (config *CompressionConfig) WithExcludeKeyPatterns(patterns []string) *CompressionConfig {
+	config.excludeKeyPatterns = patterns
+	return config
+}
+
+// WithCompressedCommands restricts compression to the given subset of commands (e.g. "SET",
+// "GETSET", "MSET"). If unset, all commands that carry a compressible value are eligible.
+// Commands that cannot meaningfully operate on a compressed payload (APPEND, SETRANGE,
+// GETRANGE, BITCOUNT, etc.) are rejected by Validate if listed here.
+func (config *CompressionConfig) WithCompressedCommands(commands []string) *CompressionConfig {
+	config.compressedCommands = commands
+	return config
+}
+
+// GetIncludeKeyPatterns returns the configured include patterns, or nil if unset.
+func (config *CompressionConfig) GetIncludeKeyPatterns() []string {
+	return config.includeKeyPatterns
+}
+
+// GetExcludeKeyPatterns returns the configured exclude patterns, or nil if unset.
+func (config *CompressionConfig) GetExcludeKeyPatterns() []string {
+	return config.excludeKeyPatterns
+}
+
+// GetCompressedCommands returns the configured command allowlist, or nil if unset (meaning all
+// commands are eligible).
+func (config *CompressionConfig) GetCompressedCommands() []string {
+	return config.compressedCommands
+}
+
+// MatchesKeyPolicy reports whether key is eligible for compression under the configured include
+// and exclude key patterns. Exclude patterns take precedence over include patterns. Patterns use
+// Redis KEYS glob semantics (see redisGlobMatch), not path/filepath.Match, so `*`/`?` cross `/`.
+// A malformed pattern never matches, since Validate rejects malformed patterns before a config
+// can be used.
+func (config *CompressionConfig) MatchesKeyPolicy(key string) bool {
+	for _, pattern := range config.excludeKeyPatterns {
+		if redisGlobMatch(pattern, key) {
+			return false
+		}
+	}
+
+	if len(config.includeKeyPatterns) == 0 {
+		return true
+	}
+	for _, pattern := range config.includeKeyPatterns {
+		if redisGlobMatch(pattern, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCommandCompressible reports whether command is eligible for compression under the
+// configured command allowlist. Comparison is case-insensitive.
+func (config *CompressionConfig) IsCommandCompressible(command string) bool {
+	if len(config.compressedCommands) == 0 {
+		return true
+	}
+	command = strings.ToUpper(command)
+	for _, c := range config.compressedCommands {
+		if strings.ToUpper(c) == command {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePolicy checks the key-pattern and command-scoping rules and returns the first
+// violation found, or nil if the policy is internally consistent.
+func (config *CompressionConfig) validatePolicy() error {
+	for _, pattern := range config.includeKeyPatterns {
+		if err := validateGlobPattern(pattern); err != nil {
+			return fmt.Errorf("invalid includeKeyPatterns pattern %q: %w", pattern, err)
+		}
+	}
+	for _, pattern := range config.excludeKeyPatterns {
+		if err := validateGlobPattern(pattern); err != nil {
+			return fmt.Errorf("invalid excludeKeyPatterns pattern %q: %w", pattern, err)
+		}
+	}
+
+	excluded := make(map[string]struct{}, len(config.excludeKeyPatterns))
+	for _, pattern := range config.excludeKeyPatterns {
+		excluded[pattern] = struct{}{}
+	}
+	for _, pattern := range config.includeKeyPatterns {
+		if _, ok := excluded[pattern]; ok {
+			return fmt.Errorf("pattern %q appears in both includeKeyPatterns and excludeKeyPatterns", pattern)
+		}
+	}
+
+	for _, command := range config.compressedCommands {
+		if _, unsafe := incompatibleCompressionCommands[strings.ToUpper(command)]; unsafe {
+			return fmt.Errorf("command %q cannot operate on compressed payloads and must not be listed in compressedCommands", command)
+		}
+	}
+
+	return nil
+}
+
+// redisGlobMatch reports whether s matches pattern using the same glob semantics as the Redis
+// KEYS command (ported from Redis's stringmatchlen): `*` matches any run of characters including
+// none, `?` matches exactly one character, `[...]` matches any one character in the set (a
+// leading `^` negates it, and `a-z` ranges are supported), and `\` escapes the next character
+// literally. Unlike path/filepath.Match, `*` and `?` are not special-cased around `/`.
+func redisGlobMatch(pattern, s string) bool {
+	return globMatch([]byte(pattern), []byte(s))
+}
+
+func globMatch(pattern, s []byte) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(s); i++ {
+				if globMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+			s = s[1:]
+		case '[':
+			if len(s) == 0 {
+				return false
+			}
+			pattern = pattern[1:]
+			negate := len(pattern) > 0 && pattern[0] == '^'
+			if negate {
+				pattern = pattern[1:]
+			}
+			matched := false
+			for len(pattern) > 0 && pattern[0] != ']' {
+				switch {
+				case pattern[0] == '\\' && len(pattern) >= 2:
+					pattern = pattern[1:]
+					if pattern[0] == s[0] {
+						matched = true
+					}
+				case len(pattern) >= 3 && pattern[1] == '-':
+					lo, hi := pattern[0], pattern[2]
+					if lo > hi {
+						lo, hi = hi, lo
+					}
+					if s[0] >= lo && s[0] <= hi {
+						matched = true
+					}
+					pattern = pattern[2:]
+				default:
+					if pattern[0] == s[0] {
+						matched = true
+					}
+				}
+				pattern = pattern[1:]
+			}
+			if len(pattern) > 0 {
+				pattern = pattern[1:] // skip the closing ']'
+			}
+			if negate {
+				matched = !matched
+			}
+			if !matched {
+				return false
+			}
+			s = s[1:]
+			continue
+		case '\\':
+			if len(pattern) >= 2 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(s) == 0 || pattern[0] != s[0] {
+				return false
+			}
+			s = s[1:]
+		}
+		pattern = pattern[1:]
+	}
+	return len(s) == 0
+}
+
+// validateGlobPattern reports a malformed pattern: an unterminated `[...]` character class or a
+// trailing unescaped `\`. It does not otherwise evaluate the pattern against any string.
+func validateGlobPattern(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 >= len(pattern) {
+				return fmt.Errorf("trailing unescaped backslash")
+			}
+			i++
+		case '[':
+			j := i + 1
+			if j < len(pattern) && pattern[j] == '^' {
+				j++
+			}
+			closed := false
+			for ; j < len(pattern); j++ {
+				if pattern[j] == '\\' {
+					j++
+					continue
+				}
+				if pattern[j] == ']' {
+					closed = true
+					break
+				}
+			}
+			if !closed {
+				return fmt.Errorf("unterminated character class")
+			}
+			i = j
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfig_MatchesKeyPolicy_NoPatternsMeansAllEligible(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.True(t, config.MatchesKeyPolicy("any:key"))
+}
+
+func TestCompressionConfig_MatchesKeyPolicy_IncludeOnly(t *testing.T) {
+	config := NewCompressionConfig().WithIncludeKeyPatterns([]string{"session:*"})
+
+	assert.True(t, config.MatchesKeyPolicy("session:123"))
+	assert.False(t, config.MatchesKeyPolicy("user:123"))
+}
+
+func TestCompressionConfig_MatchesKeyPolicy_ExcludeTakesPrecedence(t *testing.T) {
+	config := NewCompressionConfig().
+		WithIncludeKeyPatterns([]string{"session:*"}).
+		WithExcludeKeyPatterns([]string{"session:hot:*"})
+
+	assert.True(t, config.MatchesKeyPolicy("session:123"))
+	assert.False(t, config.MatchesKeyPolicy("session:hot:123"))
+}
+
+func TestCompressionConfig_IsCommandCompressible(t *testing.T) {
+	config := NewCompressionConfig().WithCompressedCommands([]string{"SET", "MSET"})
+
+	assert.True(t, config.IsCommandCompressible("set"))
+	assert.True(t, config.IsCommandCompressible("MSET"))
+	assert.False(t, config.IsCommandCompressible("GETSET"))
+}
+
+func TestCompressionConfig_IsCommandCompressible_NoAllowlistMeansAllEligible(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.True(t, config.IsCommandCompressible("APPEND"))
+}
+
+func TestCompressionConfig_Validate_RejectsOverlappingIncludeExclude(t *testing.T) {
+	config := NewCompressionConfig().
+		WithIncludeKeyPatterns([]string{"session:*"}).
+		WithExcludeKeyPatterns([]string{"session:*"})
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "appears in both")
+}
+
+func TestCompressionConfig_Validate_RejectsUnsafeCompressedCommand(t *testing.T) {
+	config := NewCompressionConfig().WithCompressedCommands([]string{"SET", "APPEND"})
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "APPEND")
+}
+
+func TestCompressionConfig_Validate_RejectsMalformedPattern(t *testing.T) {
+	config := NewCompressionConfig().WithIncludeKeyPatterns([]string{"["})
+
+	err := config.Validate()
+	assert.Error(t, err)
+}
+
+func TestCompressionConfig_Validate_AcceptsSafePolicy(t *testing.T) {
+	config := NewCompressionConfig().
+		WithIncludeKeyPatterns([]string{"session:*"}).
+		WithExcludeKeyPatterns([]string{"session:hot:*"}).
+		WithCompressedCommands([]string{"SET", "MSET", "GETSET"})
+
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_ToProtobuf_IncludesKeyAndCommandPolicy(t *testing.T) {
+	config := NewCompressionConfig().
+		WithIncludeKeyPatterns([]string{"session:*"}).
+		WithExcludeKeyPatterns([]string{"session:hot:*"}).
+		WithCompressedCommands([]string{"SET", "MSET"})
+
+	proto := config.toProtobuf()
+	assert.Equal(t, []string{"session:*"}, proto.IncludeKeyPatterns)
+	assert.Equal(t, []string{"session:hot:*"}, proto.ExcludeKeyPatterns)
+	assert.Equal(t, []string{"SET", "MSET"}, proto.CompressedCommands)
+}
@@ -0,0 +1,181 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var compressionBackendNames = map[string]CompressionBackend{
+	"zstd":    Zstd,
+	"lz4":     Lz4,
+	"gzip":    Gzip,
+	"snappy":  Snappy,
+	"deflate": Deflate,
+	"zlib":    Zlib,
+	"brotli":  Brotli,
+	"noop":    None,
+}
+
+var compressionBackendStrings = map[CompressionBackend]string{
+	Zstd:    "zstd",
+	Lz4:     "lz4",
+	Gzip:    "gzip",
+	Snappy:  "snappy",
+	Deflate: "deflate",
+	Zlib:    "zlib",
+	Brotli:  "brotli",
+	None:    "noop",
+}
+
+// ParseCompressionSpec parses a compact compression specification such as "none", "zstd",
+// "zstd/3", "lz4:9", or "zstd/3,min=128,max=1048576" into a fully-populated CompressionConfig.
+//
+// The spec is split on the first "/" or ":" into a backend name and an optional level, then any
+// remaining comma-separated "key=value" modifiers are applied. Supported modifiers are "min" and
+// "max", which map to MinCompressionSize and MaxCompressionSize respectively.
+//
+// "none" is a shorthand for WithEnabled(false); it disables compression outright and takes no
+// level or modifiers. To keep compression enabled with the None backend (values pass through
+// unchanged, but the rest of the configuration still applies), use "noop" instead.
+func ParseCompressionSpec(spec string) (*CompressionConfig, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("compression spec must not be empty")
+	}
+
+	body, modifiers, hasModifiers := strings.Cut(spec, ",")
+	backendPart, levelPart, hasLevel := cutBackendAndLevel(body)
+
+	backendName := strings.ToLower(strings.TrimSpace(backendPart))
+	if backendName == "none" {
+		config := NewCompressionConfig().WithEnabled(false)
+		if hasLevel {
+			return nil, fmt.Errorf("compression spec %q: \"none\" does not take a level", spec)
+		}
+		if hasModifiers {
+			if err := applyCompressionModifiers(config, modifiers); err != nil {
+				return nil, fmt.Errorf("compression spec %q: %w", spec, err)
+			}
+		}
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("compression spec %q: %w", spec, err)
+		}
+		return config, nil
+	}
+
+	backend, ok := compressionBackendNames[backendName]
+	if !ok {
+		return nil, fmt.Errorf("compression spec %q: unknown backend %q", spec, backendPart)
+	}
+
+	config := NewCompressionConfig().WithEnabled(true).WithBackend(backend)
+
+	if hasLevel {
+		level, err := strconv.Atoi(strings.TrimSpace(levelPart))
+		if err != nil {
+			return nil, fmt.Errorf("compression spec %q: invalid level %q: %w", spec, levelPart, err)
+		}
+		config = config.WithCompressionLevel(level)
+	}
+
+	if hasModifiers {
+		if err := applyCompressionModifiers(config, modifiers); err != nil {
+			return nil, fmt.Errorf("compression spec %q: %w", spec, err)
+		}
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("compression spec %q: %w", spec, err)
+	}
+
+	return config, nil
+}
+
+// cutBackendAndLevel splits body on the first "/" or ":" separator, whichever occurs first.
+func cutBackendAndLevel(body string) (backend, level string, hasLevel bool) {
+	slashIdx := strings.IndexByte(body, '/')
+	colonIdx := strings.IndexByte(body, ':')
+
+	idx := -1
+	switch {
+	case slashIdx == -1:
+		idx = colonIdx
+	case colonIdx == -1:
+		idx = slashIdx
+	case slashIdx < colonIdx:
+		idx = slashIdx
+	default:
+		idx = colonIdx
+	}
+
+	if idx == -1 {
+		return body, "", false
+	}
+	return body[:idx], body[idx+1:], true
+}
+
+func applyCompressionModifiers(config *CompressionConfig, modifiers string) error {
+	for _, part := range strings.Split(modifiers, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("invalid modifier %q, expected key=value", part)
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("invalid value for modifier %q: %w", key, err)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "min":
+			config.WithMinCompressionSize(size)
+		case "max":
+			config.WithMaxCompressionSize(size)
+		default:
+			return fmt.Errorf("unknown modifier %q", key)
+		}
+	}
+	return nil
+}
+
+// String renders the configuration back into the compact form accepted by ParseCompressionSpec,
+// so a config round-trips through ParseCompressionSpec(config.String()).
+func (config *CompressionConfig) String() string {
+	if !config.enabled {
+		return "none"
+	}
+
+	var b strings.Builder
+	b.WriteString(compressionBackendStrings[config.backend])
+
+	if config.compressionLevel != nil {
+		fmt.Fprintf(&b, "/%d", *config.compressionLevel)
+	}
+
+	if config.minCompressionSize != 64 {
+		fmt.Fprintf(&b, ",min=%d", config.minCompressionSize)
+	}
+	if config.maxCompressionSize != nil {
+		fmt.Fprintf(&b, ",max=%d", *config.maxCompressionSize)
+	}
+
+	return b.String()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, allowing CompressionConfig to be populated
+// directly from environment variables, YAML, or JSON string fields using ParseCompressionSpec's
+// syntax.
+func (config *CompressionConfig) UnmarshalText(text []byte) error {
+	parsed, err := ParseCompressionSpec(string(text))
+	if err != nil {
+		return err
+	}
+	*config = *parsed
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompressionSpec_None(t *testing.T) {
+	config, err := ParseCompressionSpec("none")
+	assert.NoError(t, err)
+	assert.False(t, config.IsEnabled())
+}
+
+func TestParseCompressionSpec_BackendOnly(t *testing.T) {
+	config, err := ParseCompressionSpec("zstd")
+	assert.NoError(t, err)
+	assert.True(t, config.IsEnabled())
+	assert.Equal(t, Zstd, config.GetBackend())
+	assert.Nil(t, config.GetCompressionLevel())
+}
+
+func TestParseCompressionSpec_BackendWithSlashLevel(t *testing.T) {
+	config, err := ParseCompressionSpec("zstd/3")
+	assert.NoError(t, err)
+	assert.Equal(t, Zstd, config.GetBackend())
+	assert.Equal(t, 3, *config.GetCompressionLevel())
+}
+
+func TestParseCompressionSpec_BackendWithColonLevel(t *testing.T) {
+	config, err := ParseCompressionSpec("lz4:9")
+	assert.NoError(t, err)
+	assert.Equal(t, Lz4, config.GetBackend())
+	assert.Equal(t, 9, *config.GetCompressionLevel())
+}
+
+func TestParseCompressionSpec_WithModifiers(t *testing.T) {
+	config, err := ParseCompressionSpec("zstd/3,min=128,max=1048576")
+	assert.NoError(t, err)
+	assert.Equal(t, Zstd, config.GetBackend())
+	assert.Equal(t, 3, *config.GetCompressionLevel())
+	assert.Equal(t, 128, config.GetMinCompressionSize())
+	assert.Equal(t, 1048576, *config.GetMaxCompressionSize())
+}
+
+func TestParseCompressionSpec_UnknownBackend(t *testing.T) {
+	_, err := ParseCompressionSpec("frobnicate")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown backend")
+}
+
+func TestParseCompressionSpec_InvalidLevel(t *testing.T) {
+	_, err := ParseCompressionSpec("zstd/notanumber")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid level")
+}
+
+func TestParseCompressionSpec_InvalidLevelForBackend(t *testing.T) {
+	_, err := ParseCompressionSpec("zstd/100")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "compressionLevel for ZSTD backend")
+}
+
+func TestParseCompressionSpec_UnknownModifier(t *testing.T) {
+	_, err := ParseCompressionSpec("zstd/3,bogus=1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown modifier")
+}
+
+func TestCompressionConfig_StringRoundTrip(t *testing.T) {
+	original := NewCompressionConfig().
+		WithEnabled(true).
+		WithBackend(Lz4).
+		WithCompressionLevel(9).
+		WithMinCompressionSize(128).
+		WithMaxCompressionSize(1024)
+
+	spec := original.String()
+
+	roundTripped, err := ParseCompressionSpec(spec)
+	assert.NoError(t, err)
+	assert.Equal(t, original.IsEnabled(), roundTripped.IsEnabled())
+	assert.Equal(t, original.GetBackend(), roundTripped.GetBackend())
+	assert.Equal(t, *original.GetCompressionLevel(), *roundTripped.GetCompressionLevel())
+	assert.Equal(t, original.GetMinCompressionSize(), roundTripped.GetMinCompressionSize())
+	assert.Equal(t, *original.GetMaxCompressionSize(), *roundTripped.GetMaxCompressionSize())
+}
+
+func TestCompressionConfig_StringDisabled(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, "none", config.String())
+}
+
+func TestCompressionConfig_StringNoneBackendRoundTrip(t *testing.T) {
+	original := NewCompressionConfig().WithEnabled(true).WithBackend(None)
+
+	spec := original.String()
+	assert.Equal(t, "noop", spec)
+
+	roundTripped, err := ParseCompressionSpec(spec)
+	assert.NoError(t, err)
+	assert.True(t, roundTripped.IsEnabled())
+	assert.Equal(t, None, roundTripped.GetBackend())
+}
+
+func TestCompressionConfig_UnmarshalText(t *testing.T) {
+	var config CompressionConfig
+	err := config.UnmarshalText([]byte("zstd/6,min=32"))
+	assert.NoError(t, err)
+	assert.True(t, config.IsEnabled())
+	assert.Equal(t, Zstd, config.GetBackend())
+	assert.Equal(t, 6, *config.GetCompressionLevel())
+	assert.Equal(t, 32, config.GetMinCompressionSize())
+}
@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package prometheus provides a config.CompressionMetrics implementation backed by
+// prometheus/client_golang, for users who already scrape a Prometheus endpoint and want
+// compression telemetry alongside their other metrics instead of the built-in
+// config.ExpvarCompressionMetrics.
+package prometheus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// Sink is a config.CompressionMetrics implementation that records compression telemetry as
+// Prometheus collectors. Register it with a prometheus.Registerer before use.
+type Sink struct {
+	bytesIn        *prometheus.CounterVec
+	bytesOut       *prometheus.CounterVec
+	compressed     *prometheus.CounterVec
+	decompressed   *prometheus.CounterVec
+	skipped        *prometheus.CounterVec
+	errors         *prometheus.CounterVec
+	compressSecs   *prometheus.HistogramVec
+	decompressSecs *prometheus.HistogramVec
+}
+
+// NewSink creates a Sink and registers its collectors with reg.
+func NewSink(reg prometheus.Registerer) *Sink {
+	s := &Sink{
+		bytesIn: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_bytes_in_total",
+			Help: "Total uncompressed bytes seen by the compression path, by backend.",
+		}, []string{"backend"}),
+		bytesOut: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_bytes_out_total",
+			Help: "Total compressed bytes produced by the compression path, by backend.",
+		}, []string{"backend"}),
+		compressed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_compressed_total",
+			Help: "Total values compressed, by backend.",
+		}, []string{"backend"}),
+		decompressed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_decompressed_total",
+			Help: "Total values decompressed, by backend.",
+		}, []string{"backend"}),
+		skipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_skipped_total",
+			Help: "Total values left uncompressed, by reason.",
+		}, []string{"reason"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "valkey_glide_compression_errors_total",
+			Help: "Total compression/decompression failures, by op and backend.",
+		}, []string{"op", "backend"}),
+		compressSecs: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "valkey_glide_compression_compress_seconds",
+			Help: "Compression operation latency, by backend.",
+		}, []string{"backend"}),
+		decompressSecs: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "valkey_glide_compression_decompress_seconds",
+			Help: "Decompression operation latency, by backend.",
+		}, []string{"backend"}),
+	}
+
+	reg.MustRegister(s.bytesIn, s.bytesOut, s.compressed, s.decompressed, s.skipped, s.errors, s.compressSecs, s.decompressSecs)
+	return s
+}
+
+func (s *Sink) RecordCompressed(backend string, origSize, compSize int, dur time.Duration) {
+	s.bytesIn.WithLabelValues(backend).Add(float64(origSize))
+	s.bytesOut.WithLabelValues(backend).Add(float64(compSize))
+	s.compressed.WithLabelValues(backend).Inc()
+	s.compressSecs.WithLabelValues(backend).Observe(dur.Seconds())
+}
+
+func (s *Sink) RecordDecompressed(backend string, origSize, compSize int, dur time.Duration) {
+	s.decompressed.WithLabelValues(backend).Inc()
+	s.decompressSecs.WithLabelValues(backend).Observe(dur.Seconds())
+}
+
+func (s *Sink) RecordSkipped(reason config.SkipReason, size int) {
+	s.skipped.WithLabelValues(string(reason)).Inc()
+}
+
+func (s *Sink) RecordError(op, backend string, err error) {
+	s.errors.WithLabelValues(op, backend).Inc()
+}
+
+var _ config.CompressionMetrics = (*Sink)(nil)
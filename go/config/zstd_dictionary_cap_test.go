@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfig_GetMaxZstdDictionarySize_DefaultsToAbsoluteMax(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, maxZstdDictionarySize, config.GetMaxZstdDictionarySize())
+}
+
+func TestCompressionConfig_WithMaxZstdDictionarySize_Override(t *testing.T) {
+	config := NewCompressionConfig().WithMaxZstdDictionarySize(RecommendedSmallValueDictionarySize)
+	assert.Equal(t, RecommendedSmallValueDictionarySize, config.GetMaxZstdDictionarySize())
+}
+
+func TestCompressionConfig_WithMaxZstdDictionarySize_RejectsOverAbsoluteCeiling(t *testing.T) {
+	config := NewCompressionConfig().WithMaxZstdDictionarySize(maxZstdDictionarySize + 1)
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot exceed")
+}
+
+func TestCompressionConfig_WithMaxZstdDictionarySize_EnforcedOnDictionary(t *testing.T) {
+	config := NewCompressionConfig().
+		WithBackend(Zstd).
+		WithMaxZstdDictionarySize(RecommendedSmallValueDictionarySize).
+		WithZstdDictionary(make([]byte, RecommendedSmallValueDictionarySize+1))
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "zstdDictionary must be under")
+}
+
+func TestCompressionConfig_ToProtobuf_IncludesDictionaryHash(t *testing.T) {
+	config := NewCompressionConfig().WithBackend(Zstd).WithZstdDictionary([]byte("trained dictionary"))
+	proto := config.toProtobuf()
+
+	assert.NotNil(t, proto.ZstdDictionaryHash)
+}
@@ -0,0 +1,49 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/compression"
+)
+
+// ZstdImplementation selects which underlying zstd library performs Zstd compression and
+// decompression. The pure-Go and cgo implementations have materially different perf/allocation
+// profiles: the cgo binding is typically faster, while the pure-Go implementation avoids cgo's
+// call overhead and build-time dependency on a C toolchain.
+type ZstdImplementation int
+
+const (
+	// ZstdImplAuto selects the cgo implementation when the binary was built with cgo enabled,
+	// and falls back to the pure-Go implementation otherwise. This is the default.
+	ZstdImplAuto ZstdImplementation = iota
+
+	// ZstdImplPureGo always uses the pure-Go zstd implementation (klauspost/compress/zstd),
+	// regardless of whether cgo is available.
+	ZstdImplPureGo
+
+	// ZstdImplCGo always uses the cgo-backed zstd implementation (DataDog/zstd). Only selectable
+	// when the binary was built with cgo enabled; Validate rejects it otherwise.
+	ZstdImplCGo
+)
+
+// WithZstdImplementation sets which zstd implementation is used when backend is Zstd. Defaults
+// to ZstdImplAuto.
+func (config *CompressionConfig) WithZstdImplementation(impl ZstdImplementation) *CompressionConfig {
+	config.zstdImplementation = impl
+	return config
+}
+
+// GetZstdImplementation returns the configured zstd implementation.
+func (config *CompressionConfig) GetZstdImplementation() ZstdImplementation {
+	return config.zstdImplementation
+}
+
+// validateZstdImplementation rejects ZstdImplCGo on a binary that was built without cgo.
+func (config *CompressionConfig) validateZstdImplementation() error {
+	if config.zstdImplementation == ZstdImplCGo && !compression.CGoAvailable {
+		return errors.New("zstdImplementation ZstdImplCGo requires a build with cgo enabled")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/compression"
+)
+
+func TestCompressionConfig_WithZstdImplementation_DefaultsToAuto(t *testing.T) {
+	config := NewCompressionConfig()
+	assert.Equal(t, ZstdImplAuto, config.GetZstdImplementation())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithZstdImplementation_PureGo(t *testing.T) {
+	config := NewCompressionConfig().WithZstdImplementation(ZstdImplPureGo)
+	assert.Equal(t, ZstdImplPureGo, config.GetZstdImplementation())
+	assert.NoError(t, config.Validate())
+}
+
+func TestCompressionConfig_WithZstdImplementation_CGoMatchesBuildAvailability(t *testing.T) {
+	config := NewCompressionConfig().WithZstdImplementation(ZstdImplCGo)
+	err := config.Validate()
+
+	if compression.CGoAvailable {
+		assert.NoError(t, err)
+	} else {
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a build with cgo enabled")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+)
+
+const defaultMaxPooledEncoders = 8
+
+// DictID derives a stable identifier for a dictionary so pools can key encoders/decoders by
+// which dictionary they were built against. An empty dictionary hashes to 0, the "no dictionary"
+// key.
+func DictID(dict []byte) uint32 {
+	if len(dict) == 0 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(dict)
+	return h.Sum32()
+}
+
+// ZstdCodec is a pooled zstd compressionCodec. Encoders and decoders are reused across calls,
+// keyed by (level, dictID), instead of being constructed fresh per operation.
+type ZstdCodec struct {
+	level   int
+	dict    []byte
+	dictID  uint32
+	pool    *zstdPool
+	buffers *bufferPool
+}
+
+// NewZstdCodec returns a ZstdCodec at the given level, optionally with a dictionary. maxPooled
+// bounds how many encoders/decoders are retained per key; values <= 0 mean "unbounded" at the
+// underlying sync.Pool's discretion.
+func NewZstdCodec(level int, dict []byte, maxPooled int) *ZstdCodec {
+	if maxPooled <= 0 {
+		maxPooled = defaultMaxPooledEncoders
+	}
+	return &ZstdCodec{
+		level:   level,
+		dict:    dict,
+		dictID:  DictID(dict),
+		pool:    newZstdPool(maxPooled),
+		buffers: newBufferPool(4*1024, maxPooled*4),
+	}
+}
+
+// Compress appends the zstd-compressed form of src to dst, reusing a pooled encoder.
+func (c *ZstdCodec) Compress(dst, src []byte) ([]byte, error) {
+	enc, err := c.pool.getEncoder(c.level, c.dict, c.dictID)
+	if err != nil {
+		return nil, fmt.Errorf("pooled zstd: failed to acquire encoder: %w", err)
+	}
+	out := enc.EncodeAll(src, dst)
+	c.pool.putEncoder(c.level, c.dictID, enc)
+	return out, nil
+}
+
+// Decompress appends the decompressed form of src to dst, reusing a pooled decoder.
+func (c *ZstdCodec) Decompress(dst, src []byte) ([]byte, error) {
+	dec, err := c.pool.getDecoder(c.dict, c.dictID)
+	if err != nil {
+		return nil, fmt.Errorf("pooled zstd: failed to acquire decoder: %w", err)
+	}
+	out, err := dec.DecodeAll(src, dst)
+	if err != nil {
+		dec.Close()
+		return nil, fmt.Errorf("pooled zstd: decompress failed: %w", err)
+	}
+	c.pool.putDecoder(c.dictID, dec)
+	return out, nil
+}
+
+// GetBuffer returns a pooled destination buffer sized for typical payloads. Callers should
+// return it with PutBuffer once done.
+func (c *ZstdCodec) GetBuffer() []byte { return c.buffers.Get() }
+
+// PutBuffer returns a destination buffer obtained from GetBuffer to the pool.
+func (c *ZstdCodec) PutBuffer(buf []byte) { c.buffers.Put(buf) }
+
+// Lz4Codec is a pooled lz4 compressionCodec, analogous to ZstdCodec.
+type Lz4Codec struct {
+	level   int
+	pool    *lz4Pool
+	buffers *bufferPool
+}
+
+// NewLz4Codec returns an Lz4Codec at the given level. maxPooled bounds how many writers/readers
+// are retained.
+func NewLz4Codec(level int, maxPooled int) *Lz4Codec {
+	if maxPooled <= 0 {
+		maxPooled = defaultMaxPooledEncoders
+	}
+	return &Lz4Codec{
+		level:   level,
+		pool:    newLz4Pool(maxPooled),
+		buffers: newBufferPool(4*1024, maxPooled*4),
+	}
+}
+
+// Compress appends the lz4-compressed form of src to dst, reusing a pooled writer.
+func (c *Lz4Codec) Compress(dst, src []byte) ([]byte, error) {
+	w, err := c.pool.getWriter(c.level)
+	if err != nil {
+		return nil, fmt.Errorf("pooled lz4: failed to acquire writer: %w", err)
+	}
+	var buf bytes.Buffer
+	w.Reset(&buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, fmt.Errorf("pooled lz4: write failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("pooled lz4: close failed: %w", err)
+	}
+	c.pool.putWriter(c.level, w)
+	return append(dst, buf.Bytes()...), nil
+}
+
+// Decompress appends the decompressed form of src to dst, reusing a pooled reader.
+func (c *Lz4Codec) Decompress(dst, src []byte) ([]byte, error) {
+	r := c.pool.getReader()
+	r.Reset(bytes.NewReader(src))
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("pooled lz4: decompress failed: %w", err)
+	}
+	c.pool.putReader(r)
+	return append(dst, buf.Bytes()...), nil
+}
+
+// GetBuffer returns a pooled destination buffer sized for typical payloads.
+func (c *Lz4Codec) GetBuffer() []byte { return c.buffers.Get() }
+
+// PutBuffer returns a destination buffer obtained from GetBuffer to the pool.
+func (c *Lz4Codec) PutBuffer(buf []byte) { c.buffers.Put(buf) }
+
+var (
+	_ compressionCodec = (*ZstdCodec)(nil)
+	_ compressionCodec = (*Lz4Codec)(nil)
+)
@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func payload1KB() []byte {
+	return bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog. "), 23)[:1024]
+}
+
+func TestZstdCodec_RoundTrip(t *testing.T) {
+	codec := NewZstdCodec(3, nil, 4)
+	src := payload1KB()
+
+	compressed, err := codec.Compress(nil, src)
+	assert.NoError(t, err)
+	decompressed, err := codec.Decompress(nil, compressed)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(src, decompressed))
+}
+
+func TestZstdCodec_RoundTripWithDictionary(t *testing.T) {
+	dict := bytes.Repeat([]byte("dictionary-seed-data"), 20)
+	codec := NewZstdCodec(3, dict, 4)
+	src := payload1KB()
+
+	compressed, err := codec.Compress(nil, src)
+	assert.NoError(t, err)
+	decompressed, err := codec.Decompress(nil, compressed)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(src, decompressed))
+}
+
+func TestLz4Codec_RoundTrip(t *testing.T) {
+	codec := NewLz4Codec(3, 4)
+	src := payload1KB()
+
+	compressed, err := codec.Compress(nil, src)
+	assert.NoError(t, err)
+	decompressed, err := codec.Decompress(nil, compressed)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(src, decompressed))
+}
+
+func TestDictID_StableAndEmptyIsZero(t *testing.T) {
+	assert.Equal(t, uint32(0), DictID(nil))
+	assert.Equal(t, DictID([]byte("a")), DictID([]byte("a")))
+	assert.NotEqual(t, DictID([]byte("a")), DictID([]byte("b")))
+}
+
+// BenchmarkZstdCompress_Pooled measures the pooled codec path this package provides.
+func BenchmarkZstdCompress_Pooled(b *testing.B) {
+	codec := NewZstdCodec(3, nil, 16)
+	src := payload1KB()
+	dst := make([]byte, 0, 1024)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = codec.Compress(dst, src)
+	}
+}
+
+// BenchmarkZstdCompress_Unpooled constructs a fresh encoder per call, matching the allocation
+// profile of the pre-pooling code path, so the two benchmarks can be compared directly with
+// `go test -bench . -benchmem` to confirm the ≥2x allocs/op reduction the pooled path provides.
+func BenchmarkZstdCompress_Unpooled(b *testing.B) {
+	src := payload1KB()
+	dst := make([]byte, 0, 1024)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = enc.EncodeAll(src, dst)
+		enc.Close()
+	}
+}
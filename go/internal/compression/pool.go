@@ -0,0 +1,229 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package compression provides pooled, allocation-conscious codecs used internally by the
+// client's compression path. Encoders/decoders are expensive to construct (they allocate their
+// own working buffers), so this package follows the pattern used by pulsar-client-go's
+// compression layer: keep a bounded pool of reusable instances keyed by the parameters that
+// make them distinct, and hand one out per operation instead of constructing a fresh one.
+package compression
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// codecKey identifies a pool of encoders/decoders sharing the same configuration.
+type codecKey struct {
+	level  int
+	dictID uint32
+}
+
+// compressionCodec is the internal, pooled counterpart of config.CompressionBackend's public
+// surface: compress/decompress without allocating a fresh encoder or decoder per call.
+type compressionCodec interface {
+	Compress(dst, src []byte) ([]byte, error)
+	Decompress(dst, src []byte) ([]byte, error)
+}
+
+// bufferPool hands out reusable []byte buffers for compression/decompression destinations.
+type bufferPool struct {
+	pool        sync.Pool
+	maxPooled   int
+	pooledCount int64
+	mu          sync.Mutex
+}
+
+func newBufferPool(initialSize, maxPooledBuffers int) *bufferPool {
+	bp := &bufferPool{maxPooled: maxPooledBuffers}
+	bp.pool.New = func() any {
+		return make([]byte, 0, initialSize)
+	}
+	return bp
+}
+
+func (bp *bufferPool) Get() []byte {
+	return bp.pool.Get().([]byte)[:0]
+}
+
+func (bp *bufferPool) Put(buf []byte) {
+	if bp.maxPooled <= 0 {
+		bp.pool.Put(buf) //nolint:staticcheck // unbounded pooling is the explicit default
+		return
+	}
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	if bp.pooledCount >= int64(bp.maxPooled) {
+		return
+	}
+	bp.pooledCount++
+	bp.pool.Put(buf)
+}
+
+// zstdPool holds a bounded set of reusable zstd encoders/decoders keyed by (level, dictID).
+type zstdPool struct {
+	mu        sync.Mutex
+	encoders  map[codecKey][]*zstd.Encoder
+	decoders  map[codecKey][]*zstd.Decoder
+	maxPerKey int
+}
+
+func newZstdPool(maxPerKey int) *zstdPool {
+	return &zstdPool{
+		encoders:  make(map[codecKey][]*zstd.Encoder),
+		decoders:  make(map[codecKey][]*zstd.Decoder),
+		maxPerKey: maxPerKey,
+	}
+}
+
+func (p *zstdPool) getEncoder(level int, dict []byte, dictID uint32) (*zstd.Encoder, error) {
+	key := codecKey{level: level, dictID: dictID}
+
+	p.mu.Lock()
+	if pooled := p.encoders[key]; len(pooled) > 0 {
+		enc := pooled[len(pooled)-1]
+		p.encoders[key] = pooled[:len(pooled)-1]
+		p.mu.Unlock()
+		return enc, nil
+	}
+	p.mu.Unlock()
+
+	opts := []zstd.EOption{zstd.WithEncoderLevel(zstdLevelFromInt(level))}
+	if len(dict) > 0 {
+		opts = append(opts, zstd.WithEncoderDictRaw(dictID, dict))
+	}
+	return zstd.NewWriter(nil, opts...)
+}
+
+func (p *zstdPool) putEncoder(level int, dictID uint32, enc *zstd.Encoder) {
+	key := codecKey{level: level, dictID: dictID}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxPerKey > 0 && len(p.encoders[key]) >= p.maxPerKey {
+		enc.Close()
+		return
+	}
+	p.encoders[key] = append(p.encoders[key], enc)
+}
+
+func (p *zstdPool) getDecoder(dict []byte, dictID uint32) (*zstd.Decoder, error) {
+	key := codecKey{dictID: dictID}
+
+	p.mu.Lock()
+	if pooled := p.decoders[key]; len(pooled) > 0 {
+		dec := pooled[len(pooled)-1]
+		p.decoders[key] = pooled[:len(pooled)-1]
+		p.mu.Unlock()
+		return dec, nil
+	}
+	p.mu.Unlock()
+
+	var opts []zstd.DOption
+	if len(dict) > 0 {
+		opts = append(opts, zstd.WithDecoderDictRaw(dictID, dict))
+	}
+	return zstd.NewReader(nil, opts...)
+}
+
+func (p *zstdPool) putDecoder(dictID uint32, dec *zstd.Decoder) {
+	key := codecKey{dictID: dictID}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxPerKey > 0 && len(p.decoders[key]) >= p.maxPerKey {
+		dec.Close()
+		return
+	}
+	p.decoders[key] = append(p.decoders[key], dec)
+}
+
+func zstdLevelFromInt(level int) zstd.EncoderLevel {
+	switch {
+	case level <= 0:
+		return zstd.SpeedDefault
+	case level <= 1:
+		return zstd.SpeedFastest
+	case level <= 6:
+		return zstd.SpeedDefault
+	case level <= 12:
+		return zstd.SpeedBetterCompression
+	default:
+		return zstd.SpeedBestCompression
+	}
+}
+
+// lz4Pool holds a bounded set of reusable lz4 writers/readers keyed by level.
+type lz4Pool struct {
+	mu        sync.Mutex
+	writers   map[int][]*lz4.Writer
+	readers   []*lz4.Reader
+	maxPerKey int
+}
+
+func newLz4Pool(maxPerKey int) *lz4Pool {
+	return &lz4Pool{
+		writers:   make(map[int][]*lz4.Writer),
+		maxPerKey: maxPerKey,
+	}
+}
+
+func (p *lz4Pool) getWriter(level int) (*lz4.Writer, error) {
+	p.mu.Lock()
+	if pooled := p.writers[level]; len(pooled) > 0 {
+		w := pooled[len(pooled)-1]
+		p.writers[level] = pooled[:len(pooled)-1]
+		p.mu.Unlock()
+		return w, nil
+	}
+	p.mu.Unlock()
+
+	w := lz4.NewWriter(nil)
+	if err := w.Apply(lz4.CompressionLevelOption(lz4LevelFromInt(level))); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// lz4LevelFromInt maps the client's 1-12 compression-level scale onto pierrec/lz4's nine
+// discrete CompressionLevel constants (Fast, Level1..Level9), which are bit-shifted enum values
+// rather than a contiguous range.
+func lz4LevelFromInt(level int) lz4.CompressionLevel {
+	switch {
+	case level <= 0:
+		return lz4.Fast
+	case level >= 9:
+		return lz4.Level9
+	default:
+		return lz4.CompressionLevel(1 << (8 + uint(level) - 1))
+	}
+}
+
+func (p *lz4Pool) putWriter(level int, w *lz4.Writer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxPerKey > 0 && len(p.writers[level]) >= p.maxPerKey {
+		return
+	}
+	p.writers[level] = append(p.writers[level], w)
+}
+
+func (p *lz4Pool) getReader() *lz4.Reader {
+	p.mu.Lock()
+	if len(p.readers) > 0 {
+		r := p.readers[len(p.readers)-1]
+		p.readers = p.readers[:len(p.readers)-1]
+		p.mu.Unlock()
+		return r
+	}
+	p.mu.Unlock()
+	return lz4.NewReader(nil)
+}
+
+func (p *lz4Pool) putReader(r *lz4.Reader) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.maxPerKey > 0 && len(p.readers) >= p.maxPerKey {
+		return
+	}
+	p.readers = append(p.readers, r)
+}
@@ -0,0 +1,56 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//go:build cgo
+
+package compression
+
+import "github.com/DataDog/zstd"
+
+// CGoAvailable reports whether this binary was built with cgo enabled, and therefore whether
+// the CGo zstd implementation (DataDog/zstd) is available. This build includes cgo.
+const CGoAvailable = true
+
+// CompressZstdCGo compresses src at level using the cgo-backed DataDog/zstd binding, appending
+// the result to dst. It trades the pure-Go implementation's lower allocations for the throughput
+// of the reference C library. A dictionary is digested into a fresh BulkProcessor per call; this
+// package does not pool cgo encoders the way it pools the pure-Go ones.
+func CompressZstdCGo(level int, dict, src, dst []byte) ([]byte, error) {
+	if len(dict) > 0 {
+		proc, err := zstd.NewBulkProcessor(dict, level)
+		if err != nil {
+			return nil, err
+		}
+		compressed, err := proc.Compress(nil, src)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, compressed...), nil
+	}
+	compressed, err := zstd.CompressLevel(nil, src, level)
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, compressed...), nil
+}
+
+// DecompressZstdCGo decompresses src using the cgo-backed DataDog/zstd binding, appending the
+// result to dst. dict must be the same dictionary bytes passed to the matching CompressZstdCGo
+// call; the BulkProcessor's compression level is only used to build its (unused) encoding side.
+func DecompressZstdCGo(dict, src, dst []byte) ([]byte, error) {
+	if len(dict) > 0 {
+		proc, err := zstd.NewBulkProcessor(dict, zstd.DefaultCompression)
+		if err != nil {
+			return nil, err
+		}
+		decompressed, err := proc.Decompress(nil, src)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, decompressed...), nil
+	}
+	decompressed, err := zstd.Decompress(nil, src)
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, decompressed...), nil
+}
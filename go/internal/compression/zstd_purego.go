@@ -0,0 +1,21 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+//go:build !cgo
+
+package compression
+
+import "errors"
+
+// CGoAvailable reports whether this binary was built with cgo enabled, and therefore whether
+// the CGo zstd implementation (DataDog/zstd) is available. This build excludes cgo.
+const CGoAvailable = false
+
+// CompressZstdCGo and DecompressZstdCGo are unavailable in a non-cgo build; callers should check
+// CGoAvailable before using them and fall back to the pure-Go ZstdCodec otherwise.
+func CompressZstdCGo(level int, dict, src, dst []byte) ([]byte, error) {
+	return nil, errors.New("zstd cgo implementation is unavailable: binary was built without cgo")
+}
+
+func DecompressZstdCGo(dict, src, dst []byte) ([]byte, error) {
+	return nil, errors.New("zstd cgo implementation is unavailable: binary was built without cgo")
+}